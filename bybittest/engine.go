@@ -0,0 +1,406 @@
+package bybittest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/sngyai/go-bybit"
+)
+
+// Instrument : trading rules and coins of a listed symbol
+type Instrument struct {
+	Category  bybit.CategoryV5
+	Symbol    bybit.SymbolV5
+	BaseCoin  bybit.Coin
+	QuoteCoin bybit.Coin
+
+	TickSize    bybit.Decimal
+	MinPrice    bybit.Decimal
+	MaxPrice    bybit.Decimal
+	QtyStep     bybit.Decimal
+	MinOrderQty bybit.Decimal
+	MaxOrderQty bybit.Decimal
+}
+
+// DefaultInstruments : BTCUSDT and ETHUSDT perpetuals, and BTCUSDT spot
+func DefaultInstruments() []Instrument {
+	return []Instrument{
+		{
+			Category:    bybit.CategoryV5Linear,
+			Symbol:      bybit.SymbolV5BTCUSDT,
+			BaseCoin:    bybit.CoinBTC,
+			QuoteCoin:   bybit.CoinUSDT,
+			TickSize:    bybit.MustDecimal("0.10"),
+			MinPrice:    bybit.MustDecimal("0.10"),
+			MaxPrice:    bybit.MustDecimal("199999.80"),
+			QtyStep:     bybit.MustDecimal("0.001"),
+			MinOrderQty: bybit.MustDecimal("0.001"),
+			MaxOrderQty: bybit.MustDecimal("100"),
+		},
+		{
+			Category:    bybit.CategoryV5Linear,
+			Symbol:      bybit.SymbolV5ETHUSDT,
+			BaseCoin:    bybit.CoinETH,
+			QuoteCoin:   bybit.CoinUSDT,
+			TickSize:    bybit.MustDecimal("0.01"),
+			MinPrice:    bybit.MustDecimal("0.01"),
+			MaxPrice:    bybit.MustDecimal("19999.98"),
+			QtyStep:     bybit.MustDecimal("0.01"),
+			MinOrderQty: bybit.MustDecimal("0.01"),
+			MaxOrderQty: bybit.MustDecimal("1500"),
+		},
+		{
+			Category:    bybit.CategoryV5Spot,
+			Symbol:      bybit.SymbolV5BTCUSDT,
+			BaseCoin:    bybit.CoinBTC,
+			QuoteCoin:   bybit.CoinUSDT,
+			TickSize:    bybit.MustDecimal("0.01"),
+			QtyStep:     bybit.MustDecimal("0.000001"),
+			MinOrderQty: bybit.MustDecimal("0.000048"),
+			MaxOrderQty: bybit.MustDecimal("71.73956243"),
+		},
+	}
+}
+
+// time in force as V5 names them
+const (
+	timeInForceGTC      = bybit.TimeInForce("GTC")
+	timeInForceIOC      = bybit.TimeInForce("IOC")
+	timeInForceFOK      = bybit.TimeInForce("FOK")
+	timeInForcePostOnly = bybit.TimeInForce("PostOnly")
+)
+
+// normalizeTimeInForce : accepts the legacy names as well, GTC by default
+func normalizeTimeInForce(tif bybit.TimeInForce) bybit.TimeInForce {
+	switch tif {
+	case timeInForceIOC, bybit.TimeInForceImmediateOrCancel:
+		return timeInForceIOC
+	case timeInForceFOK, bybit.TimeInForceFillOrKill:
+		return timeInForceFOK
+	case timeInForcePostOnly:
+		return timeInForcePostOnly
+	default:
+		return timeInForceGTC
+	}
+}
+
+// pricePrecision : decimal places of the average prices
+const pricePrecision = 8
+
+type marketKey struct {
+	category bybit.CategoryV5
+	symbol   bybit.SymbolV5
+}
+
+type order struct {
+	id          string
+	linkID      string
+	category    bybit.CategoryV5
+	symbol      bybit.SymbolV5
+	side        bybit.Side
+	orderType   bybit.OrderType
+	timeInForce bybit.TimeInForce
+	price       decimal.Decimal
+	qty         decimal.Decimal
+	cumQty      decimal.Decimal
+	cumValue    decimal.Decimal
+	reduceOnly  bool
+	status      bybit.OrderStatus
+	reason      string
+	createdAt   time.Time
+	updatedAt   time.Time
+	seq         int64
+	user        bool // false for the liquidity added by scenarios, which is not reported to the client
+}
+
+func (o *order) leavesQty() decimal.Decimal {
+	return o.qty.Sub(o.cumQty)
+}
+
+func (o *order) avgPrice() decimal.Decimal {
+	if o.cumQty.IsZero() {
+		return decimal.Zero
+	}
+	return o.cumValue.DivRound(o.cumQty, pricePrecision)
+}
+
+func (o *order) isOpen() bool {
+	return o.status == bybit.OrderStatusNew || o.status == bybit.OrderStatusPartiallyFilled
+}
+
+type position struct {
+	size      decimal.Decimal // negative when short
+	avgPrice  decimal.Decimal
+	leverage  decimal.Decimal
+	realised  decimal.Decimal
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func (p *position) side() bybit.Side {
+	switch p.size.Sign() {
+	case 1:
+		return bybit.SideBuy
+	case -1:
+		return bybit.SideSell
+	default:
+		return bybit.SideNone
+	}
+}
+
+type book struct {
+	bids     []*order // best first, then by time
+	asks     []*order
+	updateID int64
+	seq      int64
+
+	lastPrice decimal.Decimal
+	volume    decimal.Decimal
+	turnover  decimal.Decimal
+	changed   map[bybit.Side]map[string]decimal.Decimal // touched price levels since the last delta
+}
+
+func newBook() *book {
+	return &book{changed: map[bybit.Side]map[string]decimal.Decimal{}}
+}
+
+func (b *book) side(side bybit.Side) *[]*order {
+	if side == bybit.SideBuy {
+		return &b.bids
+	}
+	return &b.asks
+}
+
+func (b *book) touch(side bybit.Side, price decimal.Decimal) {
+	if b.changed[side] == nil {
+		b.changed[side] = map[string]decimal.Decimal{}
+	}
+	b.changed[side][price.String()] = price
+}
+
+func (b *book) insert(o *order) {
+	orders := b.side(o.side)
+	i := sort.Search(len(*orders), func(i int) bool {
+		other := (*orders)[i]
+		if o.side == bybit.SideBuy {
+			return other.price.LessThan(o.price)
+		}
+		return other.price.GreaterThan(o.price)
+	})
+	*orders = append(*orders, nil)
+	copy((*orders)[i+1:], (*orders)[i:])
+	(*orders)[i] = o
+	b.touch(o.side, o.price)
+}
+
+func (b *book) remove(o *order) {
+	orders := b.side(o.side)
+	for i, other := range *orders {
+		if other == o {
+			*orders = append((*orders)[:i], (*orders)[i+1:]...)
+			b.touch(o.side, o.price)
+			return
+		}
+	}
+}
+
+// levelQty : resting qty at the price
+func (b *book) levelQty(side bybit.Side, price decimal.Decimal) decimal.Decimal {
+	qty := decimal.Zero
+	for _, o := range *b.side(side) {
+		if o.price.Equal(price) {
+			qty = qty.Add(o.leavesQty())
+		}
+	}
+	return qty
+}
+
+// levels : aggregated [price, qty] pairs, best first. depth <= 0 for all
+func (b *book) levels(side bybit.Side, depth int) [][]string {
+	levels := [][]string{}
+	var last decimal.Decimal
+	for _, o := range *b.side(side) {
+		if len(levels) > 0 && o.price.Equal(last) {
+			continue
+		}
+		if depth > 0 && len(levels) == depth {
+			break
+		}
+		last = o.price
+		levels = append(levels, []string{o.price.String(), b.levelQty(side, o.price).String()})
+	}
+	return levels
+}
+
+// takeChanges : touched levels with their current qty, 0 for removed levels
+func (b *book) takeChanges(side bybit.Side) [][]string {
+	prices := make([]decimal.Decimal, 0, len(b.changed[side]))
+	for _, price := range b.changed[side] {
+		prices = append(prices, price)
+	}
+	sort.Slice(prices, func(i, j int) bool {
+		if side == bybit.SideBuy {
+			return prices[i].GreaterThan(prices[j])
+		}
+		return prices[i].LessThan(prices[j])
+	})
+	delete(b.changed, side)
+
+	changes := make([][]string, 0, len(prices))
+	for _, price := range prices {
+		changes = append(changes, []string{price.String(), b.levelQty(side, price).String()})
+	}
+	return changes
+}
+
+func (b *book) best(side bybit.Side) (decimal.Decimal, decimal.Decimal, bool) {
+	orders := *b.side(side)
+	if len(orders) == 0 {
+		return decimal.Zero, decimal.Zero, false
+	}
+	return orders[0].price, b.levelQty(side, orders[0].price), true
+}
+
+func opposite(side bybit.Side) bybit.Side {
+	if side == bybit.SideBuy {
+		return bybit.SideSell
+	}
+	return bybit.SideBuy
+}
+
+// crosses : whether taker can trade against a maker at price
+func crosses(taker *order, price decimal.Decimal) bool {
+	if taker.orderType == bybit.OrderTypeMarket {
+		return true
+	}
+	if taker.side == bybit.SideBuy {
+		return taker.price.GreaterThanOrEqual(price)
+	}
+	return taker.price.LessThanOrEqual(price)
+}
+
+// fill : a trade between a resting and an incoming order
+type fill struct {
+	maker *order
+	taker *order
+	price decimal.Decimal
+	qty   decimal.Decimal
+}
+
+// match : executes o against the book, then rests, cancels or rejects what is left of it
+func (b *book) match(o *order, now time.Time) []fill {
+	makers := b.side(opposite(o.side))
+
+	if o.timeInForce == timeInForcePostOnly && len(*makers) > 0 && crosses(o, (*makers)[0].price) {
+		o.status = bybit.OrderStatusCancelled
+		o.reason = "EC_PostOnlyWillTakeLiquidity"
+		return nil
+	}
+	if o.timeInForce == timeInForceFOK {
+		available := decimal.Zero
+		for _, maker := range *makers {
+			if !crosses(o, maker.price) {
+				break
+			}
+			available = available.Add(maker.leavesQty())
+		}
+		if available.LessThan(o.qty) {
+			o.status = bybit.OrderStatusCancelled
+			o.reason = "EC_FOKCannotFill"
+			return nil
+		}
+	}
+
+	var fills []fill
+	for o.leavesQty().IsPositive() && len(*makers) > 0 && crosses(o, (*makers)[0].price) {
+		maker := (*makers)[0]
+		qty := decimal.Min(o.leavesQty(), maker.leavesQty())
+
+		for _, x := range []*order{maker, o} {
+			x.cumQty = x.cumQty.Add(qty)
+			x.cumValue = x.cumValue.Add(qty.Mul(maker.price))
+			x.updatedAt = now
+			x.status = bybit.OrderStatusPartiallyFilled
+			if !x.leavesQty().IsPositive() {
+				x.status = bybit.OrderStatusFilled
+			}
+		}
+		b.touch(maker.side, maker.price)
+		if maker.status == bybit.OrderStatusFilled {
+			*makers = (*makers)[1:]
+		}
+		b.lastPrice = maker.price
+		b.volume = b.volume.Add(qty)
+		b.turnover = b.turnover.Add(qty.Mul(maker.price))
+		fills = append(fills, fill{maker: maker, taker: o, price: maker.price, qty: qty})
+	}
+
+	if o.leavesQty().IsPositive() {
+		if o.orderType == bybit.OrderTypeMarket || o.timeInForce == timeInForceIOC || o.timeInForce == timeInForceFOK {
+			o.status = bybit.OrderStatusCancelled
+		} else {
+			if o.status == "" {
+				o.status = bybit.OrderStatusNew
+			}
+			b.insert(o)
+		}
+	}
+	return fills
+}
+
+// apply : nets a fill of a user order into the position, returning the realised pnl
+func (p *position) apply(side bybit.Side, qty, price decimal.Decimal, now time.Time) decimal.Decimal {
+	signed := qty
+	if side == bybit.SideSell {
+		signed = qty.Neg()
+	}
+	if p.size.IsZero() {
+		p.createdAt = now
+	}
+	p.updatedAt = now
+
+	realised := decimal.Zero
+	switch {
+	case p.size.IsZero() || p.size.Sign() == signed.Sign():
+		total := p.size.Abs().Add(qty)
+		p.avgPrice = p.size.Abs().Mul(p.avgPrice).Add(qty.Mul(price)).DivRound(total, pricePrecision)
+		p.size = p.size.Add(signed)
+	default:
+		closing := decimal.Min(p.size.Abs(), qty)
+		realised = closing.Mul(price.Sub(p.avgPrice))
+		if p.size.IsNegative() {
+			realised = realised.Neg()
+		}
+		p.size = p.size.Add(signed)
+		switch {
+		case p.size.IsZero():
+			p.avgPrice = decimal.Zero
+		case p.size.Sign() == signed.Sign():
+			p.avgPrice = price
+		}
+	}
+	p.realised = p.realised.Add(realised)
+	return realised
+}
+
+func newID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	s := hex.EncodeToString(b)
+	return s[0:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:]
+}
+
+func millis(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10)
+}
+
+func toDecimal(d decimal.Decimal) bybit.Decimal {
+	return bybit.DecimalFrom(d)
+}
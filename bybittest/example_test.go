@@ -0,0 +1,242 @@
+package bybittest_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/sngyai/go-bybit"
+	"github.com/sngyai/go-bybit/bybittest"
+	"github.com/sngyai/go-bybit/rest"
+	"github.com/sngyai/go-bybit/ws"
+	"github.com/sngyai/go-bybit/ws/wsv5"
+)
+
+func Example() {
+	server := bybittest.NewServer(bybittest.WithAPIKey("key", "secret"))
+	defer server.Close()
+
+	client := rest.NewClient().WithBaseURL(server.URL()).WithAuth("key", "secret")
+
+	if err := server.AddLiquidity(bybit.CategoryV5Linear, bybit.SymbolV5BTCUSDT, bybit.SideSell, "30000", "1"); err != nil {
+		fmt.Println(err)
+		return
+	}
+	if _, err := client.V5().Order().CreateOrder(rest.V5CreateOrderParam{
+		Category:  bybit.CategoryV5Linear,
+		Symbol:    bybit.SymbolV5BTCUSDT,
+		Side:      bybit.SideBuy,
+		OrderType: bybit.OrderTypeMarket,
+		Qty:       bybit.MustDecimal("0.5"),
+	}); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	symbol := bybit.SymbolV5BTCUSDT
+	orders, err := client.V5().Order().GetOrderHistory(rest.V5GetOrderHistoryParam{
+		Category: bybit.CategoryV5Linear,
+		Symbol:   &symbol,
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, order := range orders.Result.List {
+		fmt.Println("order", order.OrderStatus, order.CumExecQty, order.AvgPrice)
+	}
+
+	positions, err := client.V5().Position().GetPositionInfo(rest.V5GetPositionInfoParam{
+		Category: bybit.CategoryV5Linear,
+		Symbol:   &symbol,
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, position := range positions.Result.List {
+		fmt.Println("position", position.Side, position.Size, position.AvgPrice)
+	}
+
+	wallet, err := client.V5().Account().GetWalletBalance(bybit.AccountTypeUnified, []bybit.Coin{bybit.CoinUSDT})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, list := range wallet.Result.List {
+		for _, coin := range list.Coin {
+			fmt.Println("wallet", coin.Coin, coin.WalletBalance)
+		}
+	}
+	// Output:
+	// order Filled 0.5 30000
+	// position Buy 0.5 30000
+	// wallet USDT 100000
+}
+
+func Example_privateStream() {
+	server := bybittest.NewServer(bybittest.WithAPIKey("key", "secret"))
+	defer server.Close()
+
+	client := rest.NewClient().WithBaseURL(server.URL()).WithAuth("key", "secret")
+	wsClient := ws.NewWebsocketClient().WithBaseURL(server.WebsocketURL()).WithAuth("key", "secret")
+
+	svc, err := wsv5.NewWSClient(wsClient).Private()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	if err := svc.Subscribe(); err != nil {
+		fmt.Println(err)
+		return
+	}
+	orders := make(chan wsv5.PrivateOrderData, 10)
+	if _, err := svc.SubscribeOrder(func(response wsv5.PrivateOrderResponse) error {
+		for _, data := range response.Data {
+			orders <- data
+		}
+		return nil
+	}); err != nil {
+		fmt.Println(err)
+		return
+	}
+	positions := make(chan wsv5.PrivatePositionData, 10)
+	if _, err := svc.SubscribePosition(func(response wsv5.PrivatePositionResponse) error {
+		for _, data := range response.Data {
+			positions <- data
+		}
+		return nil
+	}); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	wallets := make(chan wsv5.PrivateWalletData, 10)
+	if _, err := svc.SubscribeWallet(func(response wsv5.PrivateWalletResponse) error {
+		for _, data := range response.Data {
+			wallets <- data
+		}
+		return nil
+	}); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go svc.Supervise(ctx, nil, nil)
+	waitTopics(server, wsv5.PrivatePath, 3)
+
+	price := bybit.MustDecimal("29000")
+	if _, err := client.V5().Order().CreateOrder(rest.V5CreateOrderParam{
+		Category:  bybit.CategoryV5Linear,
+		Symbol:    bybit.SymbolV5BTCUSDT,
+		Side:      bybit.SideBuy,
+		OrderType: bybit.OrderTypeLimit,
+		Qty:       bybit.MustDecimal("0.2"),
+		Price:     &price,
+	}); err != nil {
+		fmt.Println(err)
+		return
+	}
+	order := <-orders
+	fmt.Println("order", order.OrderStatus, order.LeavesQty)
+
+	// another participant sells into the resting order
+	if err := server.Trade(bybit.CategoryV5Linear, bybit.SymbolV5BTCUSDT, bybit.SideSell, "0.2"); err != nil {
+		fmt.Println(err)
+		return
+	}
+	order = <-orders
+	fmt.Println("order", order.OrderStatus, order.CumExecQty)
+	position := <-positions
+	fmt.Println("position", position.Side, position.Size, position.EntryPrice)
+	wallet := <-wallets
+	fmt.Println("wallet", wallet.TotalWalletBalance)
+	// Output:
+	// order New 0.2
+	// order Filled 0.2
+	// position Buy 0.2 29000
+	// wallet 100000
+}
+
+func ExampleServer_InjectFault() {
+	server := bybittest.NewServer()
+	defer server.Close()
+
+	client := rest.NewClient().WithBaseURL(server.URL())
+
+	// as the CDN answers a banned IP
+	server.InjectFault(bybittest.StatusFault("/v5/market/time", 1, http.StatusForbidden))
+	_, err := client.V5().Market().GetServerTime()
+	fmt.Println(errors.Is(err, rest.ErrAccessDenied))
+
+	// retried with backoff until the fault is exhausted
+	server.InjectFault(bybittest.Fault{Path: "/v5/market/time", Times: 2, StatusCode: http.StatusBadGateway})
+	_, err = client.WithRetryPolicy(&rest.RetryPolicy{
+		MaxAttempts:      3,
+		BaseDelay:        time.Millisecond,
+		MaxDelay:         10 * time.Millisecond,
+		MaxRateLimitWait: time.Second,
+	}).V5().Market().GetServerTime()
+	fmt.Println(err)
+	// Output:
+	// true
+	// <nil>
+}
+
+func ExampleWithRateLimit() {
+	server := bybittest.NewServer(bybittest.WithRateLimit(1))
+	defer server.Close()
+
+	// the rate limiter of the client learns the limit from the X-Bapi-Limit headers,
+	// so the second call waits for the reset instead of being rejected
+	client := rest.NewClient().WithBaseURL(server.URL())
+	_, err := client.V5().Market().GetServerTime()
+	fmt.Println(err)
+	_, err = client.V5().Market().GetServerTime()
+	fmt.Println(err)
+
+	// without it, the exchange rejects the call until the retry policy waited for the reset
+	unlimited := rest.NewClient().WithBaseURL(server.URL()).WithRateLimiter(nil)
+	_, err = unlimited.V5().Market().GetServerTime()
+	fmt.Println(errors.Is(err, rest.ErrTooManyVisits))
+	_, err = unlimited.WithRetryPolicy(rest.DefaultRetryPolicy()).V5().Market().GetServerTime()
+	fmt.Println(err)
+	// Output:
+	// <nil>
+	// <nil>
+	// true
+	// <nil>
+}
+
+func ExampleWithRSAPublicKey() {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	server := bybittest.NewServer(bybittest.WithRSAPublicKey("key", &privateKey.PublicKey))
+	defer server.Close()
+
+	client := rest.NewClient().WithBaseURL(server.URL()).WithSigner("key", bybit.NewRSASigner(privateKey))
+	_, err = client.V5().Account().GetWalletBalance(bybit.AccountTypeUnified, nil)
+	fmt.Println(err)
+
+	_, err = client.WithAuth("key", "secret").V5().Account().GetWalletBalance(bybit.AccountTypeUnified, nil)
+	fmt.Println(errors.Is(err, rest.ErrInvalidSignature))
+	// Output:
+	// <nil>
+	// true
+}
+
+// waitTopics : until the connections of path subscribed to n topics
+func waitTopics(server *bybittest.Server, path string, n int) {
+	for len(server.Topics(path)) < n {
+		time.Sleep(10 * time.Millisecond)
+	}
+}
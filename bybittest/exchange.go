@@ -0,0 +1,226 @@
+package bybittest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/sngyai/go-bybit"
+)
+
+func (s *Server) instrument(category bybit.CategoryV5, symbol bybit.SymbolV5) (Instrument, bool) {
+	for _, instrument := range s.instruments {
+		if instrument.Category == category && instrument.Symbol == symbol {
+			return instrument, true
+		}
+	}
+	return Instrument{}, false
+}
+
+func (s *Server) newOrderLocked(o *order) *order {
+	s.seq++
+	o.seq = s.seq
+	o.id = newID()
+	o.createdAt = s.now()
+	o.updatedAt = o.createdAt
+	return o
+}
+
+func (s *Server) positionLocked(key marketKey) *position {
+	p, ok := s.positions[key]
+	if !ok {
+		p = &position{leverage: decimal.NewFromInt(10)}
+		s.positions[key] = p
+	}
+	return p
+}
+
+// executeLocked : matches o, settles the fills of the client and publishes what changed
+func (s *Server) executeLocked(o *order) {
+	key := marketKey{o.category, o.symbol}
+	instrument, _ := s.instrument(o.category, o.symbol)
+	now := s.now()
+	fills := s.books[key].match(o, now)
+
+	var changed []*order
+	if o.user {
+		changed = append(changed, o)
+	}
+	settled := false
+	for _, f := range fills {
+		if f.maker.user {
+			if !containsOrder(changed, f.maker) {
+				changed = append(changed, f.maker)
+			}
+			s.settleLocked(instrument, f.maker.side, f.qty, f.price, now)
+			settled = true
+		}
+		if f.taker.user {
+			s.settleLocked(instrument, f.taker.side, f.qty, f.price, now)
+			settled = true
+		}
+	}
+
+	s.publishMarketLocked(key, fills, now)
+	s.publishOrdersLocked(changed, now)
+	if settled {
+		if instrument.Category != bybit.CategoryV5Spot {
+			s.publishPositionLocked(key, now)
+		}
+		s.publishWalletLocked(now)
+	}
+}
+
+// settleLocked : applies a fill of the client to its position or spot balances
+func (s *Server) settleLocked(instrument Instrument, side bybit.Side, qty, price decimal.Decimal, now time.Time) {
+	if instrument.Category == bybit.CategoryV5Spot {
+		value := qty.Mul(price)
+		if side == bybit.SideBuy {
+			s.balances[instrument.BaseCoin] = s.balances[instrument.BaseCoin].Add(qty)
+			s.balances[instrument.QuoteCoin] = s.balances[instrument.QuoteCoin].Sub(value)
+		} else {
+			s.balances[instrument.BaseCoin] = s.balances[instrument.BaseCoin].Sub(qty)
+			s.balances[instrument.QuoteCoin] = s.balances[instrument.QuoteCoin].Add(value)
+		}
+		return
+	}
+
+	p := s.positionLocked(marketKey{instrument.Category, instrument.Symbol})
+	realised := p.apply(side, qty, price, now)
+	s.balances[instrument.QuoteCoin] = s.balances[instrument.QuoteCoin].Add(realised)
+	s.realised[instrument.QuoteCoin] = s.realised[instrument.QuoteCoin].Add(realised)
+}
+
+// cancelLocked : an open order of the client
+func (s *Server) cancelLocked(o *order) {
+	key := marketKey{o.category, o.symbol}
+	now := s.now()
+	s.books[key].remove(o)
+	o.status = bybit.OrderStatusCancelled
+	o.updatedAt = now
+
+	s.publishMarketLocked(key, nil, now)
+	s.publishOrdersLocked([]*order{o}, now)
+}
+
+// findOrderLocked : by orderId, or by orderLinkId when orderId is empty
+func (s *Server) findOrderLocked(category bybit.CategoryV5, orderID string, orderLinkID string) *order {
+	for i := len(s.orders) - 1; i >= 0; i-- {
+		o := s.orders[i]
+		if o.category != category {
+			continue
+		}
+		if (orderID != "" && o.id == orderID) || (orderID == "" && orderLinkID != "" && o.linkID == orderLinkID) {
+			return o
+		}
+	}
+	return nil
+}
+
+// qtyForQuote : the base qty a spot market buy of amount quote coin can fill, rounded down to step
+func (b *book) qtyForQuote(amount decimal.Decimal, step decimal.Decimal) decimal.Decimal {
+	qty := decimal.Zero
+	for _, maker := range b.asks {
+		if !amount.IsPositive() {
+			break
+		}
+		value := maker.leavesQty().Mul(maker.price)
+		if value.LessThanOrEqual(amount) {
+			qty = qty.Add(maker.leavesQty())
+			amount = amount.Sub(value)
+			continue
+		}
+		qty = qty.Add(amount.Div(maker.price))
+		break
+	}
+	if step.IsPositive() {
+		qty = qty.Div(step).Floor().Mul(step)
+	}
+	return qty
+}
+
+func containsOrder(orders []*order, o *order) bool {
+	for _, other := range orders {
+		if other == o {
+			return true
+		}
+	}
+	return false
+}
+
+// AddLiquidity : rests a limit order on the book, which is not the client's. Whatever part of it
+// crosses the book trades first, filling the orders of the client in its way
+func (s *Server) AddLiquidity(category bybit.CategoryV5, symbol bybit.SymbolV5, side bybit.Side, price string, qty string) error {
+	return s.trade(category, symbol, side, bybit.OrderTypeLimit, price, qty)
+}
+
+// Trade : a market order which is not the client's, e.g. to fill the orders of the client resting on the book
+func (s *Server) Trade(category bybit.CategoryV5, symbol bybit.SymbolV5, side bybit.Side, qty string) error {
+	return s.trade(category, symbol, side, bybit.OrderTypeMarket, "", qty)
+}
+
+func (s *Server) trade(category bybit.CategoryV5, symbol bybit.SymbolV5, side bybit.Side, orderType bybit.OrderType, price string, qty string) error {
+	if _, ok := s.instrument(category, symbol); !ok {
+		return fmt.Errorf("unknown instrument: %s %s", category, symbol)
+	}
+	if side != bybit.SideBuy && side != bybit.SideSell {
+		return fmt.Errorf("invalid side: %s", side)
+	}
+	o := &order{
+		category:    category,
+		symbol:      symbol,
+		side:        side,
+		orderType:   orderType,
+		timeInForce: timeInForceGTC,
+	}
+	var err error
+	if o.qty, err = decimal.NewFromString(qty); err != nil {
+		return fmt.Errorf("qty: %w", err)
+	}
+	if orderType == bybit.OrderTypeLimit {
+		if o.price, err = decimal.NewFromString(price); err != nil {
+			return fmt.Errorf("price: %w", err)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.executeLocked(s.newOrderLocked(o))
+	return nil
+}
+
+// ClearLiquidity : removes the orders added by AddLiquidity from the book
+func (s *Server) ClearLiquidity(category bybit.CategoryV5, symbol bybit.SymbolV5) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := marketKey{category, symbol}
+	b, ok := s.books[key]
+	if !ok {
+		return
+	}
+	for _, side := range []bybit.Side{bybit.SideBuy, bybit.SideSell} {
+		for _, o := range append([]*order(nil), *b.side(side)...) {
+			if !o.user {
+				b.remove(o)
+			}
+		}
+	}
+	s.publishMarketLocked(key, nil, s.now())
+}
+
+// SetBalance : of a coin of the wallet
+func (s *Server) SetBalance(coin bybit.Coin, amount string) error {
+	v, err := decimal.NewFromString(amount)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.balances[coin] = v
+	s.publishWalletLocked(s.now())
+	return nil
+}
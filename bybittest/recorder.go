@@ -0,0 +1,382 @@
+package bybittest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// RecorderMode :
+type RecorderMode int
+
+const (
+	// RecorderModeReplay : answers from the fixture file, without network
+	RecorderModeReplay = RecorderMode(iota)
+	// RecorderModeRecord : sends the requests, and writes them with their responses to the fixture file
+	RecorderModeRecord
+	// RecorderModeAuto : replays when the fixture file exists, records otherwise
+	RecorderModeAuto
+)
+
+// redacted : replaces the credentials in the fixtures
+const redacted = "REDACTED"
+
+var (
+	// volatileParams : differ on every request, so are left out of the match
+	volatileParams = []string{"api_key", "sign", "timestamp", "recv_window"}
+
+	credentialParams  = map[string]bool{"api_key": true, "sign": true}
+	credentialHeaders = []string{"X-BAPI-API-KEY", "X-BAPI-SIGN"}
+
+	// credentialFields : of the responses, e.g. of V5User.CreateSubAPIKey and GetAPIKey
+	credentialFields = map[string]bool{"apiKey": true, "secret": true, "api_key": true, "api_secret": true}
+	// credentialResponseHeaders : of the responses
+	credentialResponseHeaders = []string{"Set-Cookie", "X-BAPI-API-KEY", "X-BAPI-SIGN"}
+)
+
+// ErrNoRecordedResponse : replayed request which the fixture has no response for
+var ErrNoRecordedResponse = errors.New("bybittest: no recorded response")
+
+// Interaction : a request and its response, as written in the fixture file
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest :
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// RecordedResponse :
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+type fixture struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Recorder : an http.RoundTripper recording the requests to a fixture file, or replaying them from it.
+//
+//	recorder, err := bybittest.NewRecorder("testdata/orders.json", bybittest.RecorderModeAuto)
+//	if err != nil {
+//		return err
+//	}
+//	client := rest.NewClient().WithHTTPClient(recorder.Client()).WithAuth(key, secret)
+//
+// Requests match by method, path, and query and body with sorted keys, leaving out the keys,
+// signatures, timestamps and recv windows. Matching requests are answered in the order they were
+// recorded, the last answer repeating once they are exhausted. The keys and signatures of the requests,
+// and the apiKey and secret fields and the cookies of the responses, are redacted in the fixture file
+type Recorder struct {
+	path      string
+	recording bool
+	transport http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	replayed     map[string]int
+}
+
+// NewRecorder : loads the fixture file at path to replay it
+func NewRecorder(path string, mode RecorderMode) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		transport: http.DefaultTransport,
+		replayed:  map[string]int{},
+	}
+
+	buf, err := os.ReadFile(path)
+	switch {
+	case mode == RecorderModeRecord:
+		r.recording = true
+	case mode == RecorderModeAuto && errors.Is(err, os.ErrNotExist):
+		r.recording = true
+	case err != nil:
+		return nil, err
+	default:
+		var f fixture
+		if err := json.Unmarshal(buf, &f); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		r.interactions = f.Interactions
+	}
+	return r, nil
+}
+
+// WithTransport : sends the recorded requests, http.DefaultTransport by default
+func (r *Recorder) WithTransport(transport http.RoundTripper) *Recorder {
+	r.transport = transport
+
+	return r
+}
+
+// Recording : whether the requests are sent, rather than replayed
+func (r *Recorder) Recording() bool {
+	return r.recording
+}
+
+// Client : for rest.Client.WithHTTPClient
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip :
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		_ = req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	if r.recording {
+		return r.record(req, body)
+	}
+	return r.replay(req, body)
+}
+
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	key := matchKey(req.Method, req.URL, body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var matches []*Interaction
+	for _, interaction := range r.interactions {
+		u, err := url.Parse(interaction.Request.URL)
+		if err != nil {
+			continue
+		}
+		if matchKey(interaction.Request.Method, u, []byte(interaction.Request.Body)) == key {
+			matches = append(matches, interaction)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("%w for %s %s", ErrNoRecordedResponse, req.Method, req.URL.Path)
+	}
+
+	i := r.replayed[key]
+	if i >= len(matches) {
+		i = len(matches) - 1
+	}
+	r.replayed[key]++
+
+	recorded := matches[i].Response
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        recorded.Header.Clone(),
+		Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}, nil
+}
+
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	res, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	resBody, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+	header := redactHeader(req.Header, credentialHeaders)
+	u := *req.URL
+	u.RawQuery = redactQuery(u.RawQuery)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.interactions = append(r.interactions, &Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    u.String(),
+			Header: header,
+			Body:   redactBody(body),
+		},
+		Response: RecordedResponse{
+			StatusCode: res.StatusCode,
+			Header:     redactHeader(res.Header, credentialResponseHeaders),
+			Body:       redactResponseBody(resBody),
+		},
+	})
+	if err := r.saveLocked(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// saveLocked : rewrites the fixture file with every interaction recorded so far
+func (r *Recorder) saveLocked() error {
+	buf, err := json.MarshalIndent(fixture{Interactions: r.interactions}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, append(buf, '\n'), 0o644)
+}
+
+// matchKey : method, path, and the canonical query and body
+func matchKey(method string, u *url.URL, body []byte) string {
+	return method + " " + u.Path + "?" + canonicalQuery(u.RawQuery) + " " + canonicalBody(body)
+}
+
+func canonicalQuery(rawQuery string) string {
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return rawQuery
+	}
+	for _, name := range volatileParams {
+		values.Del(name)
+	}
+	return values.Encode()
+}
+
+// canonicalBody : JSON with sorted keys, or a sorted form
+func canonicalBody(body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+	if fields, ok := jsonFields(body); ok {
+		for _, name := range volatileParams {
+			delete(fields, name)
+		}
+		buf, err := json.Marshal(fields)
+		if err == nil {
+			return string(buf)
+		}
+	}
+	return canonicalQuery(string(body))
+}
+
+// jsonFields : numbers are kept as written
+func jsonFields(body []byte) (map[string]interface{}, bool) {
+	var fields map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&fields); err != nil {
+		return nil, false
+	}
+	return fields, true
+}
+
+func redactQuery(rawQuery string) string {
+	if rawQuery == "" {
+		return ""
+	}
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return rawQuery
+	}
+	for name := range values {
+		if credentialParams[name] {
+			values.Set(name, redacted)
+		}
+	}
+	return values.Encode()
+}
+
+// redactBody : the credentials of the legacy JSON and form bodies. The body stays as sent otherwise
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if fields, ok := jsonFields(body); ok {
+		found := false
+		for name := range fields {
+			if credentialParams[name] {
+				fields[name] = redacted
+				found = true
+			}
+		}
+		if !found {
+			return string(body)
+		}
+		if buf, err := json.Marshal(fields); err == nil {
+			return string(buf)
+		}
+		return string(body)
+	}
+	if values, err := url.ParseQuery(string(body)); err == nil {
+		for name := range values {
+			if credentialParams[name] {
+				return redactQuery(string(body))
+			}
+		}
+	}
+	return string(body)
+}
+
+// redactHeader : a copy of header with the values of names redacted
+func redactHeader(header http.Header, names []string) http.Header {
+	header = header.Clone()
+	for _, name := range names {
+		if header.Get(name) != "" {
+			header.Set(name, redacted)
+		}
+	}
+	return header
+}
+
+// redactResponseBody : the credential fields at any depth of a JSON body. The body stays as received otherwise
+func redactResponseBody(body []byte) string {
+	var v interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil || !redactFields(v) {
+		return string(body)
+	}
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return string(body)
+	}
+	return string(buf)
+}
+
+// redactFields : reports whether any field was redacted
+func redactFields(v interface{}) bool {
+	found := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for name, field := range v {
+			if _, ok := field.(string); ok && credentialFields[name] {
+				v[name] = redacted
+				found = true
+				continue
+			}
+			found = redactFields(field) || found
+		}
+	case []interface{}:
+		for _, item := range v {
+			found = redactFields(item) || found
+		}
+	}
+	return found
+}
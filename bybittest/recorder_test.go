@@ -0,0 +1,261 @@
+package bybittest
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecorderRedacts(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		query    string
+		header   http.Header
+		body     string
+		response string
+		want     []string
+		wantNot  []string
+	}{
+		{
+			name:     "V5 headers and nested response fields",
+			method:   http.MethodGet,
+			query:    "limit=1",
+			header:   http.Header{"X-Bapi-Api-Key": {"live-key"}, "X-Bapi-Sign": {"live-sign"}},
+			response: `{"retCode":0,"result":{"id":"1","apiKey":"live-sub-key","secret":"live-secret","readOnly":0}}`,
+			want:     []string{"limit=1", `\"id\":\"1\"`, `\"readOnly\":0`},
+			wantNot:  []string{"live-key", "live-sign", "live-sub-key", "live-secret", "live-cookie"},
+		},
+		{
+			name:     "response fields in lists",
+			method:   http.MethodGet,
+			query:    "",
+			response: `{"retCode":0,"result":{"list":[{"apiKey":"live-sub-key","secret":"live-secret"}]}}`,
+			wantNot:  []string{"live-sub-key", "live-secret", "live-cookie"},
+		},
+		{
+			name:     "legacy query",
+			method:   http.MethodGet,
+			query:    "api_key=live-key&sign=live-sign&symbol=BTCUSD",
+			response: `{"ret_code":0}`,
+			want:     []string{"symbol=BTCUSD", `{\"ret_code\":0}`},
+			wantNot:  []string{"live-key", "live-sign", "live-cookie"},
+		},
+		{
+			name:     "legacy JSON body",
+			method:   http.MethodPost,
+			body:     `{"api_key":"live-key","sign":"live-sign","symbol":"BTCUSD"}`,
+			response: `{"ret_code":0}`,
+			want:     []string{"BTCUSD"},
+			wantNot:  []string{"live-key", "live-sign", "live-cookie"},
+		},
+		{
+			name:     "legacy form body",
+			method:   http.MethodPost,
+			body:     "api_key=live-key&sign=live-sign&symbol=BTCUSD",
+			response: `{"ret_code":0}`,
+			want:     []string{"BTCUSD"},
+			wantNot:  []string{"live-key", "live-sign", "live-cookie"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Set-Cookie", "session=live-cookie")
+				_, _ = io.WriteString(w, tt.response)
+			}))
+			defer server.Close()
+
+			path := filepath.Join(t.TempDir(), "fixture.json")
+			recorder, err := NewRecorder(path, RecorderModeRecord)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var body io.Reader
+			if tt.body != "" {
+				body = strings.NewReader(tt.body)
+			}
+			req, err := http.NewRequest(tt.method, server.URL+"/v5/test?"+tt.query, body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for name, values := range tt.header {
+				req.Header[name] = values
+			}
+			res, err := recorder.Client().Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := io.ReadAll(res.Body)
+			_ = res.Body.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.response {
+				t.Errorf("got response %s, want it as received %s", got, tt.response)
+			}
+
+			buf, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			fixture := string(buf)
+			for _, s := range tt.want {
+				if !strings.Contains(fixture, s) {
+					t.Errorf("fixture lacks %s:\n%s", s, fixture)
+				}
+			}
+			for _, s := range tt.wantNot {
+				if strings.Contains(fixture, s) {
+					t.Errorf("fixture contains %s:\n%s", s, fixture)
+				}
+			}
+		})
+	}
+}
+
+func TestMatchKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    []string
+		url       []string
+		body      []string
+		wantEqual bool
+	}{
+		{
+			name:      "query order",
+			method:    []string{http.MethodGet, http.MethodGet},
+			url:       []string{"/v5/order/realtime?symbol=BTCUSDT&category=linear", "/v5/order/realtime?category=linear&symbol=BTCUSDT"},
+			body:      []string{"", ""},
+			wantEqual: true,
+		},
+		{
+			name:      "volatile query params",
+			method:    []string{http.MethodGet, http.MethodGet},
+			url:       []string{"/v1/order?api_key=a&sign=b&timestamp=1&recv_window=5000&symbol=BTCUSD", "/v1/order?symbol=BTCUSD&timestamp=2&sign=c&api_key=REDACTED"},
+			body:      []string{"", ""},
+			wantEqual: true,
+		},
+		{
+			name:      "JSON key order and whitespace",
+			method:    []string{http.MethodPost, http.MethodPost},
+			url:       []string{"/v5/order/create", "/v5/order/create"},
+			body:      []string{`{"symbol":"BTCUSDT","qty":"0.10"}`, `{ "qty": "0.10", "symbol": "BTCUSDT" }`},
+			wantEqual: true,
+		},
+		{
+			name:      "volatile JSON fields",
+			method:    []string{http.MethodPost, http.MethodPost},
+			url:       []string{"/v2/private/order/create", "/v2/private/order/create"},
+			body:      []string{`{"api_key":"a","sign":"b","timestamp":1,"symbol":"BTCUSD"}`, `{"symbol":"BTCUSD","timestamp":2,"sign":"REDACTED","api_key":"REDACTED"}`},
+			wantEqual: true,
+		},
+		{
+			name:      "form order",
+			method:    []string{http.MethodPost, http.MethodPost},
+			url:       []string{"/v1/order", "/v1/order"},
+			body:      []string{"symbol=BTCUSD&side=Buy&sign=a", "side=Buy&symbol=BTCUSD&sign=b"},
+			wantEqual: true,
+		},
+		{
+			name:   "numbers kept as written",
+			method: []string{http.MethodPost, http.MethodPost},
+			url:    []string{"/v5/order/create", "/v5/order/create"},
+			body:   []string{`{"qty":0.10}`, `{"qty":0.1}`},
+		},
+		{
+			name:   "method",
+			method: []string{http.MethodGet, http.MethodPost},
+			url:    []string{"/v5/order/realtime", "/v5/order/realtime"},
+			body:   []string{"", ""},
+		},
+		{
+			name:   "path",
+			method: []string{http.MethodGet, http.MethodGet},
+			url:    []string{"/v5/order/realtime", "/v5/order/history"},
+			body:   []string{"", ""},
+		},
+		{
+			name:   "query value",
+			method: []string{http.MethodGet, http.MethodGet},
+			url:    []string{"/v5/order/realtime?symbol=BTCUSDT", "/v5/order/realtime?symbol=ETHUSDT"},
+			body:   []string{"", ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var keys [2]string
+			for i := range keys {
+				u, err := url.Parse(tt.url[i])
+				if err != nil {
+					t.Fatal(err)
+				}
+				keys[i] = matchKey(tt.method[i], u, []byte(tt.body[i]))
+			}
+			if got := keys[0] == keys[1]; got != tt.wantEqual {
+				t.Errorf("matchKey equal = %v, want %v:\n%s\n%s", got, tt.wantEqual, keys[0], keys[1])
+			}
+		})
+	}
+}
+
+func TestRecorderReplaysRecordedResponses(t *testing.T) {
+	count := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		_, _ = io.WriteString(w, `{"retCode":0,"result":{"n":`+strings.Repeat("1", count)+`}}`)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "fixture.json")
+	recorder, err := NewRecorder(path, RecorderModeAuto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !recorder.Recording() {
+		t.Fatal("not recording without a fixture file")
+	}
+	for i := 0; i < 2; i++ {
+		res, err := recorder.Client().Get(server.URL + "/v5/test?b=2&a=1&timestamp=" + strings.Repeat("9", i+1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = res.Body.Close()
+	}
+
+	replayer, err := NewRecorder(path, RecorderModeAuto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayer.Recording() {
+		t.Fatal("recording with a fixture file")
+	}
+	want := []string{`{"retCode":0,"result":{"n":1}}`, `{"retCode":0,"result":{"n":11}}`, `{"retCode":0,"result":{"n":11}}`}
+	for i, want := range want {
+		res, err := replayer.Client().Get("http://replay.invalid/v5/test?a=1&b=2&timestamp=0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := io.ReadAll(res.Body)
+		_ = res.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != want {
+			t.Errorf("replay %d: got %s, want %s", i, got, want)
+		}
+	}
+
+	_, err = replayer.Client().Get("http://replay.invalid/v5/other")
+	if !errors.Is(err, ErrNoRecordedResponse) {
+		t.Errorf("got error %v, want %v", err, ErrNoRecordedResponse)
+	}
+}
@@ -0,0 +1,616 @@
+package bybittest
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+	"github.com/sngyai/go-bybit"
+	"github.com/sngyai/go-bybit/rest"
+)
+
+// restHandler : returns the result, or a non-zero retCode and its retMsg
+type restHandler func(s *Server, r *http.Request, body []byte) (interface{}, int, string)
+
+var restHandlers = map[string]restHandler{
+	"/v5/market/time":             (*Server).handleServerTime,
+	"/v5/market/instruments-info": (*Server).handleInstrumentsInfo,
+	"/v5/market/tickers":          (*Server).handleTickers,
+	"/v5/market/orderbook":        (*Server).handleOrderbook,
+	"/v5/order/create":            (*Server).handleCreateOrder,
+	"/v5/order/amend":             (*Server).handleAmendOrder,
+	"/v5/order/cancel":            (*Server).handleCancelOrder,
+	"/v5/order/cancel-all":        (*Server).handleCancelAllOrders,
+	"/v5/order/realtime":          (*Server).handleOpenOrders,
+	"/v5/order/history":           (*Server).handleOrderHistory,
+	"/v5/position/list":           (*Server).handlePositionInfo,
+	"/v5/position/set-leverage":   (*Server).handleSetLeverage,
+	"/v5/account/wallet-balance":  (*Server).handleWalletBalance,
+}
+
+const (
+	msgSymbolInvalid      = "params error: symbol invalid"
+	msgOrderNotFound      = "order not exists or too late to cancel"
+	msgOrderLinkDuplicate = "OrderLinkedID is duplicate"
+)
+
+func (s *Server) handleServerTime(*http.Request, []byte) (interface{}, int, string) {
+	now := s.now()
+	return rest.V5GetServerTimeResult{
+		TimeSecond: strconv.FormatInt(now.Unix(), 10),
+		TimeNano:   strconv.FormatInt(now.UnixNano(), 10),
+	}, 0, ""
+}
+
+func (s *Server) handleInstrumentsInfo(r *http.Request, _ []byte) (interface{}, int, string) {
+	category := bybit.CategoryV5(r.URL.Query().Get("category"))
+	symbol := bybit.SymbolV5(r.URL.Query().Get("symbol"))
+
+	list := []interface{}{}
+	for _, instrument := range s.instruments {
+		if instrument.Category != category || (symbol != "" && instrument.Symbol != symbol) {
+			continue
+		}
+		if category == bybit.CategoryV5Spot {
+			list = append(list, map[string]interface{}{
+				"symbol":    instrument.Symbol,
+				"baseCoin":  instrument.BaseCoin,
+				"quoteCoin": instrument.QuoteCoin,
+				"status":    bybit.InstrumentStatusTrading,
+				"lotSizeFilter": map[string]interface{}{
+					"basePrecision":  instrument.QtyStep,
+					"quotePrecision": instrument.TickSize,
+					"minOrderQty":    instrument.MinOrderQty,
+					"maxOrderQty":    instrument.MaxOrderQty,
+				},
+				"priceFilter": map[string]interface{}{
+					"tickSize": instrument.TickSize,
+				},
+			})
+			continue
+		}
+		list = append(list, map[string]interface{}{
+			"symbol":       instrument.Symbol,
+			"contractType": bybit.ContractTypeLinearPerpetual,
+			"status":       bybit.InstrumentStatusTrading,
+			"baseCoin":     instrument.BaseCoin,
+			"quoteCoin":    instrument.QuoteCoin,
+			"settleCoin":   instrument.QuoteCoin,
+			"priceFilter": map[string]interface{}{
+				"minPrice": instrument.MinPrice,
+				"maxPrice": instrument.MaxPrice,
+				"tickSize": instrument.TickSize,
+			},
+			"lotSizeFilter": map[string]interface{}{
+				"maxOrderQty": instrument.MaxOrderQty,
+				"minOrderQty": instrument.MinOrderQty,
+				"qtyStep":     instrument.QtyStep,
+			},
+		})
+	}
+	if len(list) == 0 && symbol != "" {
+		return nil, 10001, msgSymbolInvalid
+	}
+	return map[string]interface{}{"category": category, "nextPageCursor": "", "list": list}, 0, ""
+}
+
+func (s *Server) handleTickers(r *http.Request, _ []byte) (interface{}, int, string) {
+	category := bybit.CategoryV5(r.URL.Query().Get("category"))
+	symbol := bybit.SymbolV5(r.URL.Query().Get("symbol"))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list := []interface{}{}
+	for _, instrument := range s.instruments {
+		if instrument.Category != category || (symbol != "" && instrument.Symbol != symbol) {
+			continue
+		}
+		list = append(list, s.tickerLocked(marketKey{category, instrument.Symbol}))
+	}
+	if len(list) == 0 && symbol != "" {
+		return nil, 10001, msgSymbolInvalid
+	}
+	return map[string]interface{}{"category": category, "list": list}, 0, ""
+}
+
+// tickerLocked : shared by /v5/market/tickers and the tickers topic
+func (s *Server) tickerLocked(key marketKey) map[string]interface{} {
+	b := s.books[key]
+	ticker := map[string]interface{}{
+		"symbol":      key.symbol,
+		"lastPrice":   toDecimal(b.lastPrice),
+		"volume24h":   toDecimal(b.volume),
+		"turnover24h": toDecimal(b.turnover),
+	}
+	if price, size, ok := b.best(bybit.SideBuy); ok {
+		ticker["bid1Price"], ticker["bid1Size"] = toDecimal(price), toDecimal(size)
+	}
+	if price, size, ok := b.best(bybit.SideSell); ok {
+		ticker["ask1Price"], ticker["ask1Size"] = toDecimal(price), toDecimal(size)
+	}
+	if key.category != bybit.CategoryV5Spot {
+		ticker["markPrice"] = toDecimal(b.lastPrice)
+		ticker["indexPrice"] = toDecimal(b.lastPrice)
+	}
+	return ticker
+}
+
+func (s *Server) handleOrderbook(r *http.Request, _ []byte) (interface{}, int, string) {
+	key := marketKey{bybit.CategoryV5(r.URL.Query().Get("category")), bybit.SymbolV5(r.URL.Query().Get("symbol"))}
+	limit := 25
+	if v := r.URL.Query().Get("limit"); v != "" {
+		var err error
+		if limit, err = strconv.Atoi(v); err != nil {
+			return nil, 10001, "params error: limit invalid"
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.books[key]
+	if !ok {
+		return nil, 10001, msgSymbolInvalid
+	}
+	return map[string]interface{}{
+		"s":   key.symbol,
+		"b":   b.levels(bybit.SideBuy, limit),
+		"a":   b.levels(bybit.SideSell, limit),
+		"ts":  s.now().UnixNano() / 1e6,
+		"u":   b.updateID,
+		"seq": b.seq,
+	}, 0, ""
+}
+
+func (s *Server) handleCreateOrder(_ *http.Request, body []byte) (interface{}, int, string) {
+	var param rest.V5CreateOrderParam
+	if err := json.Unmarshal(body, &param); err != nil {
+		return nil, 10001, "params error: " + err.Error()
+	}
+	instrument, ok := s.instrument(param.Category, param.Symbol)
+	if !ok {
+		return nil, 10001, msgSymbolInvalid
+	}
+	if param.Side != bybit.SideBuy && param.Side != bybit.SideSell {
+		return nil, 10001, "params error: side invalid"
+	}
+	if param.OrderType != bybit.OrderTypeLimit && param.OrderType != bybit.OrderTypeMarket {
+		return nil, 10001, "params error: orderType invalid"
+	}
+
+	o := &order{
+		category:  param.Category,
+		symbol:    param.Symbol,
+		side:      param.Side,
+		orderType: param.OrderType,
+		qty:       param.Qty.Decimal(),
+		user:      true,
+	}
+	if param.TimeInForce != nil {
+		o.timeInForce = *param.TimeInForce
+	}
+	o.timeInForce = normalizeTimeInForce(o.timeInForce)
+	if param.OrderLinkID != nil {
+		o.linkID = *param.OrderLinkID
+	}
+	if param.ReduceOnly != nil {
+		o.reduceOnly = *param.ReduceOnly
+	}
+	if param.OrderType == bybit.OrderTypeMarket {
+		o.timeInForce = timeInForceIOC
+	} else {
+		if param.Price == nil || !param.Price.Decimal().IsPositive() {
+			return nil, 10001, "params error: price invalid"
+		}
+		o.price = param.Price.Decimal()
+		if code, msg := validatePrice(instrument, o.price); code != 0 {
+			return nil, code, msg
+		}
+	}
+
+	// a spot market buy is sized in the quote coin
+	quoteQty := instrument.Category == bybit.CategoryV5Spot && o.orderType == bybit.OrderTypeMarket && o.side == bybit.SideBuy
+	if !quoteQty {
+		if code, msg := validateQty(instrument, o.qty); code != 0 {
+			return nil, code, msg
+		}
+	} else if !o.qty.IsPositive() {
+		return nil, 10001, "Qty invalid"
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if o.linkID != "" && s.findOrderLocked(o.category, "", o.linkID) != nil {
+		return nil, 110072, msgOrderLinkDuplicate
+	}
+	if o.reduceOnly && instrument.Category != bybit.CategoryV5Spot {
+		p := s.positionLocked(marketKey{o.category, o.symbol})
+		if p.side() != opposite(o.side) {
+			return nil, 110017, "current position is zero, cannot fix reduce-only order qty"
+		}
+		o.qty = decimal.Min(o.qty, p.size.Abs())
+	}
+	if instrument.Category == bybit.CategoryV5Spot {
+		coin, need := instrument.BaseCoin, o.qty
+		if o.side == bybit.SideBuy {
+			coin = instrument.QuoteCoin
+			if !quoteQty {
+				need = o.qty.Mul(o.price)
+			}
+		}
+		if s.balances[coin].LessThan(need) {
+			return nil, 170131, "Insufficient balance."
+		}
+	}
+	if quoteQty {
+		o.qty = s.books[marketKey{o.category, o.symbol}].qtyForQuote(o.qty, instrument.QtyStep.Decimal())
+	}
+
+	s.orders = append(s.orders, s.newOrderLocked(o))
+	s.executeLocked(o)
+
+	return rest.V5CreateOrderResult{OrderID: o.id, OrderLinkID: o.linkID}, 0, ""
+}
+
+func validatePrice(instrument Instrument, price decimal.Decimal) (int, string) {
+	if instrument.TickSize.Decimal().IsPositive() && !price.Mod(instrument.TickSize.Decimal()).IsZero() {
+		return 10001, "params error: price invalid, it must be a multiple of tickSize " + instrument.TickSize.String()
+	}
+	if instrument.MinPrice.Decimal().IsPositive() && price.LessThan(instrument.MinPrice.Decimal()) {
+		return 10001, "params error: price is lower than minPrice " + instrument.MinPrice.String()
+	}
+	if instrument.MaxPrice.Decimal().IsPositive() && price.GreaterThan(instrument.MaxPrice.Decimal()) {
+		return 10001, "params error: price is higher than maxPrice " + instrument.MaxPrice.String()
+	}
+	return 0, ""
+}
+
+func validateQty(instrument Instrument, qty decimal.Decimal) (int, string) {
+	if !qty.IsPositive() || (instrument.QtyStep.Decimal().IsPositive() && !qty.Mod(instrument.QtyStep.Decimal()).IsZero()) {
+		return 10001, "Qty invalid"
+	}
+	if instrument.MinOrderQty.Decimal().IsPositive() && qty.LessThan(instrument.MinOrderQty.Decimal()) {
+		return 10001, "The number of contracts exceeds minimum limit allowed"
+	}
+	if instrument.MaxOrderQty.Decimal().IsPositive() && qty.GreaterThan(instrument.MaxOrderQty.Decimal()) {
+		return 10001, "The number of contracts exceeds maximum limit allowed"
+	}
+	return 0, ""
+}
+
+func (s *Server) handleAmendOrder(_ *http.Request, body []byte) (interface{}, int, string) {
+	var param rest.V5AmendOrderParam
+	if err := json.Unmarshal(body, &param); err != nil {
+		return nil, 10001, "params error: " + err.Error()
+	}
+	instrument, ok := s.instrument(param.Category, param.Symbol)
+	if !ok {
+		return nil, 10001, msgSymbolInvalid
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o := s.findOrderLocked(param.Category, stringValue(param.OrderID), stringValue(param.OrderLinkID))
+	if o == nil || o.symbol != param.Symbol || !o.isOpen() {
+		return nil, 110001, "order not exists or too late to replace"
+	}
+
+	price, qty := o.price, o.qty
+	if param.Price != nil && !param.Price.IsEmpty() {
+		price = param.Price.Decimal()
+		if code, msg := validatePrice(instrument, price); code != 0 {
+			return nil, code, msg
+		}
+	}
+	if param.Qty != nil && !param.Qty.IsEmpty() {
+		qty = param.Qty.Decimal()
+		if code, msg := validateQty(instrument, qty); code != 0 {
+			return nil, code, msg
+		}
+		if qty.LessThanOrEqual(o.cumQty) {
+			return nil, 10001, "Qty invalid, it must be greater than the executed qty"
+		}
+	}
+	if price.Equal(o.price) && qty.Equal(o.qty) {
+		return nil, 10001, "The order remains unchanged as the parameters entered match the existing ones."
+	}
+
+	// the order loses its time priority, and trades if its new price crosses the book
+	s.books[marketKey{o.category, o.symbol}].remove(o)
+	o.price, o.qty = price, qty
+	o.updatedAt = s.now()
+	s.executeLocked(o)
+
+	return rest.V5AmendOrderResult{OrderID: o.id, OrderLinkID: o.linkID}, 0, ""
+}
+
+func (s *Server) handleCancelOrder(_ *http.Request, body []byte) (interface{}, int, string) {
+	var param rest.V5CancelOrderParam
+	if err := json.Unmarshal(body, &param); err != nil {
+		return nil, 10001, "params error: " + err.Error()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o := s.findOrderLocked(param.Category, stringValue(param.OrderID), stringValue(param.OrderLinkID))
+	if o == nil || o.symbol != param.Symbol || !o.isOpen() {
+		return nil, 110001, msgOrderNotFound
+	}
+	s.cancelLocked(o)
+
+	return rest.V5CancelOrderResult{OrderID: o.id, OrderLinkID: o.linkID}, 0, ""
+}
+
+func (s *Server) handleCancelAllOrders(_ *http.Request, body []byte) (interface{}, int, string) {
+	var param rest.V5CancelAllOrdersParam
+	if err := json.Unmarshal(body, &param); err != nil {
+		return nil, 10001, "params error: " + err.Error()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	type item struct {
+		OrderID     string `json:"orderId"`
+		OrderLinkID string `json:"orderLinkId"`
+	}
+	list := []item{}
+	for _, o := range s.orders {
+		if o.category != param.Category || !o.isOpen() || (param.Symbol != nil && o.symbol != *param.Symbol) {
+			continue
+		}
+		s.cancelLocked(o)
+		list = append(list, item{OrderID: o.id, OrderLinkID: o.linkID})
+	}
+	return map[string]interface{}{"list": list, "success": "1"}, 0, ""
+}
+
+func (s *Server) handleOpenOrders(r *http.Request, _ []byte) (interface{}, int, string) {
+	return s.listOrders(r, func(o *order) bool { return o.isOpen() })
+}
+
+func (s *Server) handleOrderHistory(r *http.Request, _ []byte) (interface{}, int, string) {
+	return s.listOrders(r, func(o *order) bool { return !o.isOpen() })
+}
+
+// listOrders : newest first. An order asked for by orderId or orderLinkId is returned whatever its status
+func (s *Server) listOrders(r *http.Request, include func(*order) bool) (interface{}, int, string) {
+	q := r.URL.Query()
+	category := bybit.CategoryV5(q.Get("category"))
+	limit := 20
+	if v := q.Get("limit"); v != "" {
+		var err error
+		if limit, err = strconv.Atoi(v); err != nil {
+			return nil, 10001, "params error: limit invalid"
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list := []rest.V5GetOpenOrder{}
+	for i := len(s.orders) - 1; i >= 0 && len(list) < limit; i-- {
+		o := s.orders[i]
+		switch {
+		case o.category != category:
+		case q.Get("symbol") != "" && string(o.symbol) != q.Get("symbol"):
+		case q.Get("orderId") != "" && o.id != q.Get("orderId"):
+		case q.Get("orderLinkId") != "" && o.linkID != q.Get("orderLinkId"):
+		case q.Get("orderId") == "" && q.Get("orderLinkId") == "" && !include(o):
+		default:
+			list = append(list, o.openOrder())
+		}
+	}
+	return rest.V5GetOpenOrdersResult{Category: category, List: list}, 0, ""
+}
+
+func (o *order) openOrder() rest.V5GetOpenOrder {
+	return rest.V5GetOpenOrder{
+		Symbol:       o.symbol,
+		OrderType:    o.orderType,
+		OrderLinkID:  o.linkID,
+		OrderID:      o.id,
+		AvgPrice:     toDecimal(o.avgPrice()),
+		OrderStatus:  o.status,
+		CumExecValue: toDecimal(o.cumValue),
+		RejectReason: o.reasonOrNone(),
+		Price:        toDecimal(o.price),
+		CreatedTime:  millis(o.createdAt),
+		TimeInForce:  o.timeInForce,
+		LeavesValue:  toDecimal(o.leavesValue()),
+		UpdatedTime:  millis(o.updatedAt),
+		Side:         o.side,
+		LeavesQty:    toDecimal(o.leavesQty()),
+		CumExecQty:   toDecimal(o.cumQty),
+		ReduceOnly:   o.reduceOnly,
+		Qty:          toDecimal(o.qty),
+	}
+}
+
+// leavesValue : 0 once the order is no longer open
+func (o *order) leavesValue() decimal.Decimal {
+	if !o.isOpen() {
+		return decimal.Zero
+	}
+	return o.leavesQty().Mul(o.price)
+}
+
+func (o *order) reasonOrNone() string {
+	if o.reason == "" {
+		return "EC_NoError"
+	}
+	return o.reason
+}
+
+func (s *Server) handlePositionInfo(r *http.Request, _ []byte) (interface{}, int, string) {
+	category := bybit.CategoryV5(r.URL.Query().Get("category"))
+	symbol := bybit.SymbolV5(r.URL.Query().Get("symbol"))
+	if category == bybit.CategoryV5Spot {
+		return nil, 10001, "params error: category invalid"
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list := rest.V5GetPositionInfoList{}
+	for _, instrument := range s.instruments {
+		if instrument.Category != category || (symbol != "" && instrument.Symbol != symbol) {
+			continue
+		}
+		list = append(list, s.positionInfoLocked(marketKey{category, instrument.Symbol}))
+	}
+	return rest.V5GetPositionInfoResult{Category: category, List: list}, 0, ""
+}
+
+func (s *Server) positionInfoLocked(key marketKey) rest.V5GetPositionInfoItem {
+	p := s.positionLocked(key)
+	markPrice := s.books[key].lastPrice
+	return rest.V5GetPositionInfoItem{
+		Symbol:         key.symbol,
+		Leverage:       toDecimal(p.leverage),
+		AvgPrice:       toDecimal(p.avgPrice),
+		PositionValue:  toDecimal(p.size.Abs().Mul(p.avgPrice).Round(pricePrecision)),
+		TpSlMode:       bybit.TpSlModeFull,
+		UnrealisedPnl:  toDecimal(p.unrealised(markPrice)),
+		MarkPrice:      toDecimal(markPrice),
+		CumRealisedPnl: toDecimal(p.realised),
+		CreatedTime:    millis(p.createdAt),
+		UpdatedTime:    millis(p.updatedAt),
+		Side:           p.side(),
+		Size:           toDecimal(p.size.Abs()),
+		PositionStatus: "Normal",
+	}
+}
+
+// unrealised : pnl of the position at markPrice
+func (p *position) unrealised(markPrice decimal.Decimal) decimal.Decimal {
+	if p.size.IsZero() || markPrice.IsZero() {
+		return decimal.Zero
+	}
+	return p.size.Mul(markPrice.Sub(p.avgPrice)).Round(pricePrecision)
+}
+
+func (s *Server) handleSetLeverage(_ *http.Request, body []byte) (interface{}, int, string) {
+	var param rest.V5SetLeverageParam
+	if err := json.Unmarshal(body, &param); err != nil {
+		return nil, 10001, "params error: " + err.Error()
+	}
+	if _, ok := s.instrument(param.Category, param.Symbol); !ok {
+		return nil, 10001, msgSymbolInvalid
+	}
+	if !param.BuyLeverage.Decimal().Equal(param.SellLeverage.Decimal()) {
+		return nil, 10001, "buy leverage must be equal to sell leverage in one-way mode"
+	}
+	leverage := param.BuyLeverage.Decimal()
+	if param.BuyLeverage.IsEmpty() || leverage.LessThan(decimal.NewFromInt(1)) || leverage.GreaterThan(decimal.NewFromInt(100)) {
+		return nil, 10001, "params error: leverage invalid"
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := marketKey{param.Category, param.Symbol}
+	p := s.positionLocked(key)
+	if p.leverage.Equal(leverage) {
+		return nil, 110043, "leverage not modified"
+	}
+	p.leverage = leverage
+	p.updatedAt = s.now()
+	s.publishPositionLocked(key, p.updatedAt)
+
+	return struct{}{}, 0, ""
+}
+
+func (s *Server) handleWalletBalance(r *http.Request, _ []byte) (interface{}, int, string) {
+	accountType := r.URL.Query().Get("accountType")
+	if accountType != string(bybit.AccountTypeUnified) {
+		return nil, 10001, "accountType only support UNIFIED."
+	}
+	var coins []bybit.Coin
+	if v := r.URL.Query().Get("coin"); v != "" {
+		for _, coin := range strings.Split(v, ",") {
+			coins = append(coins, bybit.Coin(coin))
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return rest.V5WalletBalanceResult{List: []rest.V5WalletBalanceList{s.walletLocked(coins)}}, 0, ""
+}
+
+// walletLocked : the given coins, or the non-zero ones
+func (s *Server) walletLocked(coins []bybit.Coin) rest.V5WalletBalanceList {
+	if len(coins) == 0 {
+		for coin, balance := range s.balances {
+			if !balance.IsZero() {
+				coins = append(coins, coin)
+			}
+		}
+		sort.Slice(coins, func(i, j int) bool { return coins[i] < coins[j] })
+	}
+
+	unrealised := map[bybit.Coin]decimal.Decimal{}
+	for key, p := range s.positions {
+		if instrument, ok := s.instrument(key.category, key.symbol); ok {
+			unrealised[instrument.QuoteCoin] = unrealised[instrument.QuoteCoin].Add(p.unrealised(s.books[key].lastPrice))
+		}
+	}
+
+	wallet := rest.V5WalletBalanceList{AccountType: string(bybit.AccountTypeUnified)}
+	var totalEquity, totalWallet, totalPerpUPL decimal.Decimal
+	for _, coin := range coins {
+		balance := s.balances[coin]
+		equity := balance.Add(unrealised[coin])
+		price := s.usdPriceLocked(coin)
+
+		totalWallet = totalWallet.Add(balance.Mul(price))
+		totalEquity = totalEquity.Add(equity.Mul(price))
+		totalPerpUPL = totalPerpUPL.Add(unrealised[coin].Mul(price))
+		wallet.Coin = append(wallet.Coin, rest.V5WalletBalanceCoin{
+			Coin:                coin,
+			WalletBalance:       toDecimal(balance),
+			Equity:              toDecimal(equity),
+			AvailableToWithdraw: toDecimal(balance),
+			UsdValue:            toDecimal(equity.Mul(price)),
+			UnrealisedPnl:       toDecimal(unrealised[coin]),
+			CumRealisedPnl:      toDecimal(s.realised[coin]),
+		})
+	}
+	wallet.TotalEquity = toDecimal(totalEquity)
+	wallet.TotalWalletBalance = toDecimal(totalWallet)
+	wallet.TotalMarginBalance = toDecimal(totalEquity)
+	wallet.TotalAvailableBalance = toDecimal(totalEquity)
+	wallet.TotalPerpUPL = toDecimal(totalPerpUPL)
+	return wallet
+}
+
+// usdPriceLocked : 1 for stable coins, otherwise the last price of the coin against USDT
+func (s *Server) usdPriceLocked(coin bybit.Coin) decimal.Decimal {
+	switch coin {
+	case bybit.CoinUSDT, "USDC":
+		return decimal.NewFromInt(1)
+	}
+	for key, b := range s.books {
+		if instrument, ok := s.instrument(key.category, key.symbol); ok && instrument.BaseCoin == coin && instrument.QuoteCoin == bybit.CoinUSDT {
+			if !b.lastPrice.IsZero() {
+				return b.lastPrice
+			}
+		}
+	}
+	return decimal.Zero
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
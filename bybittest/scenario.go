@@ -0,0 +1,171 @@
+package bybittest
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sngyai/go-bybit"
+)
+
+// Fault : the server answers with it instead of handling the request
+type Fault struct {
+	// Path : of the REST endpoint, or of the stream. "" for every path, and one ending with "/" for the paths under it
+	Path string
+	// Times : how many requests it applies to. 0 until ClearFaults
+	Times int
+
+	// StatusCode : of the HTTP response, or of the websocket handshake. 200 when 0
+	StatusCode int
+	// RetCode : of the response, or fails the auth of the private stream
+	RetCode int
+	RetMsg  string
+	Header  http.Header
+}
+
+// RateLimitFault : 10006 with the X-Bapi-Limit headers of an exhausted limit
+func RateLimitFault(path string, times int) Fault {
+	header := http.Header{}
+	header.Set("X-Bapi-Limit", "10")
+	header.Set("X-Bapi-Limit-Status", "0")
+	return Fault{Path: path, Times: times, RetCode: 10006, RetMsg: "Too many visits!", Header: header}
+}
+
+// AuthFault : 10003 for a REST endpoint, a failed auth for the private stream
+func AuthFault(path string, times int) Fault {
+	return Fault{Path: path, Times: times, RetCode: 10003, RetMsg: "API key is invalid."}
+}
+
+// StatusFault : a response with statusCode, e.g. 403 as the CDN answers a banned IP
+func StatusFault(path string, times int, statusCode int) Fault {
+	return Fault{Path: path, Times: times, StatusCode: statusCode}
+}
+
+func (f *Fault) write(w http.ResponseWriter, now time.Time) {
+	for k, v := range f.Header {
+		w.Header()[k] = v
+	}
+	if f.RetCode == 10006 && w.Header().Get("X-Bapi-Limit-Reset-Timestamp") == "" {
+		w.Header().Set("X-Bapi-Limit-Reset-Timestamp", millis(now.Add(time.Second)))
+	}
+
+	if f.StatusCode != 0 && f.StatusCode != http.StatusOK {
+		msg := f.RetMsg
+		if msg == "" {
+			msg = http.StatusText(f.StatusCode)
+		}
+		http.Error(w, msg, f.StatusCode)
+		return
+	}
+	writeError(w, now, f.RetCode, f.RetMsg)
+}
+
+// InjectFault : faults apply in the order they were injected
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults : removes the injected faults
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+// takeFault : the first fault of path which match accepts, counting it as applied
+func (s *Server) takeFault(path string, match func(*Fault) bool) *Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, f := range s.faults {
+		if f.Path != "" && f.Path != path && !(strings.HasSuffix(f.Path, "/") && strings.HasPrefix(path, f.Path)) {
+			continue
+		}
+		if !match(f) {
+			continue
+		}
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		applied := *f
+		return &applied
+	}
+	return nil
+}
+
+// Step : an action of a scenario
+type Step func(ctx context.Context, s *Server) error
+
+// Play : runs the steps in order, until one fails or ctx is done
+func (s *Server) Play(ctx context.Context, steps ...Step) error {
+	for _, step := range steps {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := step(ctx, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddLiquidity : see Server.AddLiquidity
+func AddLiquidity(category bybit.CategoryV5, symbol bybit.SymbolV5, side bybit.Side, price string, qty string) Step {
+	return func(_ context.Context, s *Server) error {
+		return s.AddLiquidity(category, symbol, side, price, qty)
+	}
+}
+
+// Trade : see Server.Trade
+func Trade(category bybit.CategoryV5, symbol bybit.SymbolV5, side bybit.Side, qty string) Step {
+	return func(_ context.Context, s *Server) error {
+		return s.Trade(category, symbol, side, qty)
+	}
+}
+
+// ClearLiquidity : see Server.ClearLiquidity
+func ClearLiquidity(category bybit.CategoryV5, symbol bybit.SymbolV5) Step {
+	return func(_ context.Context, s *Server) error {
+		s.ClearLiquidity(category, symbol)
+		return nil
+	}
+}
+
+// InjectFault : see Server.InjectFault
+func InjectFault(f Fault) Step {
+	return func(_ context.Context, s *Server) error {
+		s.InjectFault(f)
+		return nil
+	}
+}
+
+// Disconnect : see Server.Disconnect
+func Disconnect(path string) Step {
+	return func(_ context.Context, s *Server) error {
+		s.Disconnect(path)
+		return nil
+	}
+}
+
+// Wait : pauses the scenario
+func Wait(d time.Duration) Step {
+	return func(ctx context.Context, _ *Server) error {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,302 @@
+// Package bybittest : a local fake of the V5 REST API and websocket streams, for tests of code built on the clients.
+//
+//	server := bybittest.NewServer(bybittest.WithAPIKey("key", "secret"))
+//	defer server.Close()
+//
+//	client := rest.NewClient().WithBaseURL(server.URL()).WithAuth("key", "secret")
+//	wsClient := ws.NewWebsocketClient().WithBaseURL(server.WebsocketURL()).WithAuth("key", "secret")
+//
+// Orders are matched against the liquidity added by AddLiquidity, or by a scenario, with price-time priority.
+// Fills update the orders, the linear positions and the wallet, and are pushed to the private stream.
+package bybittest
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/sngyai/go-bybit"
+)
+
+// Server : the fake exchange. Its methods are safe for concurrent use
+type Server struct {
+	httpServer *httptest.Server
+
+	key        string
+	secret     string
+	publicKey  *rsa.PublicKey
+	timeOffset time.Duration
+	rateLimit  int
+
+	mu          sync.Mutex
+	instruments []Instrument
+	books       map[marketKey]*book
+	orders      []*order // of the client, oldest first
+	positions   map[marketKey]*position
+	balances    map[bybit.Coin]decimal.Decimal
+	realised    map[bybit.Coin]decimal.Decimal
+	seq         int64
+	faults      []*Fault
+	visits      map[string]*visit
+	conns       map[*conn]struct{}
+}
+
+type visit struct {
+	window time.Time
+	count  int
+}
+
+// Option :
+type Option func(*Server)
+
+// WithAPIKey : requests of the private endpoints and streams must be signed with HMAC-SHA256 of secret.
+// Without it, they are accepted whatever their key and signature
+func WithAPIKey(key string, secret string) Option {
+	return func(s *Server) {
+		s.key = key
+		s.secret = secret
+	}
+}
+
+// WithRSAPublicKey : requests of the private endpoints and streams must be signed with RSA-SHA256,
+// as bybit.RSASigner does, by the private key of publicKey
+func WithRSAPublicKey(key string, publicKey *rsa.PublicKey) Option {
+	return func(s *Server) {
+		s.key = key
+		s.publicKey = publicKey
+	}
+}
+
+// WithInstruments : replaces DefaultInstruments
+func WithInstruments(instruments ...Instrument) Option {
+	return func(s *Server) {
+		s.instruments = instruments
+	}
+}
+
+// WithBalance : 100000 USDT by default
+func WithBalance(coin bybit.Coin, amount string) Option {
+	return func(s *Server) {
+		s.balances[coin] = decimal.RequireFromString(amount)
+	}
+}
+
+// WithTimeOffset : skews the clock of the server, e.g. to test rest.ClockSync
+func WithTimeOffset(d time.Duration) Option {
+	return func(s *Server) {
+		s.timeOffset = d
+	}
+}
+
+// WithRateLimit : answers 10006 once a path is requested more than limit times within a second.
+// The X-Bapi-Limit headers are only sent with it
+func WithRateLimit(limit int) Option {
+	return func(s *Server) {
+		s.rateLimit = limit
+	}
+}
+
+// NewServer : starts the server. Close it once done
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		instruments: DefaultInstruments(),
+		books:       map[marketKey]*book{},
+		positions:   map[marketKey]*position{},
+		balances:    map[bybit.Coin]decimal.Decimal{bybit.CoinUSDT: decimal.NewFromInt(100000)},
+		realised:    map[bybit.Coin]decimal.Decimal{},
+		visits:      map[string]*visit{},
+		conns:       map[*conn]struct{}{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	for _, instrument := range s.instruments {
+		s.books[marketKey{instrument.Category, instrument.Symbol}] = newBook()
+	}
+
+	s.httpServer = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// URL : for rest.Client.WithBaseURL
+func (s *Server) URL() string {
+	return s.httpServer.URL
+}
+
+// WebsocketURL : for ws.WebSocketClient.WithBaseURL
+func (s *Server) WebsocketURL() string {
+	return "ws" + strings.TrimPrefix(s.httpServer.URL, "http")
+}
+
+// Close : disconnects the streams and shuts the server down
+func (s *Server) Close() {
+	s.Disconnect("")
+	s.httpServer.Close()
+}
+
+func (s *Server) now() time.Time {
+	return time.Now().Add(s.timeOffset)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == privatePath || strings.HasPrefix(r.URL.Path, publicPath+"/") {
+		s.serveWebsocket(w, r)
+		return
+	}
+
+	handler, ok := restHandlers[r.URL.Path]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, s.now(), 10001, err.Error())
+		return
+	}
+	if s.rateLimited(w, r.URL.Path) {
+		return
+	}
+	if f := s.takeFault(r.URL.Path, func(*Fault) bool { return true }); f != nil {
+		f.write(w, s.now())
+		return
+	}
+	if !strings.HasPrefix(r.URL.Path, "/v5/market/") {
+		if code, msg := s.verify(r, body); code != 0 {
+			writeError(w, s.now(), code, msg)
+			return
+		}
+	}
+
+	result, code, msg := handler(s, r, body)
+	if code != 0 {
+		writeError(w, s.now(), code, msg)
+		return
+	}
+	writeResult(w, s.now(), result)
+}
+
+// verify : the X-BAPI-* headers of a V5 private request
+func (s *Server) verify(r *http.Request, body []byte) (int, string) {
+	if s.key == "" {
+		return 0, ""
+	}
+
+	key := r.Header.Get("X-BAPI-API-KEY")
+	if key != s.key {
+		return 10003, "API key is invalid."
+	}
+
+	timestamp, err := strconv.ParseInt(r.Header.Get("X-BAPI-TIMESTAMP"), 10, 64)
+	if err != nil {
+		return 10001, "timestamp is required"
+	}
+	recvWindow := int64(5000)
+	if v := r.Header.Get("X-BAPI-RECV-WINDOW"); v != "" {
+		if recvWindow, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return 10001, "recv_window is invalid"
+		}
+	}
+	now := s.now().UnixNano() / int64(time.Millisecond)
+	if timestamp < now-recvWindow || now+1000 <= timestamp {
+		return 10002, "invalid request, please check your server timestamp or recv_window param. req_timestamp[" +
+			strconv.FormatInt(timestamp, 10) + "],server_timestamp[" + strconv.FormatInt(now, 10) +
+			"],recv_window[" + strconv.FormatInt(recvWindow, 10) + "]"
+	}
+
+	payload := r.Header.Get("X-BAPI-TIMESTAMP") + key + r.Header.Get("X-BAPI-RECV-WINDOW")
+	if r.Method == http.MethodGet {
+		payload += r.URL.RawQuery
+	} else {
+		payload += string(body)
+	}
+	if !s.validSignature(payload, r.Header.Get("X-BAPI-SIGN")) {
+		return 10004, "error sign! origin_string[" + payload + "]"
+	}
+	return 0, ""
+}
+
+func (s *Server) validSignature(payload string, signature string) bool {
+	if s.publicKey != nil {
+		decoded, err := base64.StdEncoding.DecodeString(signature)
+		if err != nil {
+			return false
+		}
+		hashed := sha256.Sum256([]byte(payload))
+		return rsa.VerifyPKCS1v15(s.publicKey, crypto.SHA256, hashed[:], decoded) == nil
+	}
+
+	mac := hmac.New(sha256.New, []byte(s.secret))
+	mac.Write([]byte(payload))
+	expected := hex.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(expected), []byte(strings.ToLower(signature)))
+}
+
+// rateLimited : writes the rate limit headers, and the 10006 response once exceeded
+func (s *Server) rateLimited(w http.ResponseWriter, path string) bool {
+	if s.rateLimit <= 0 {
+		return false
+	}
+
+	s.mu.Lock()
+	now := s.now()
+	v, ok := s.visits[path]
+	if !ok || now.Sub(v.window) >= time.Second {
+		// aligned to the millisecond, so that the window ends exactly at the advertised reset
+		v = &visit{window: now.Truncate(time.Millisecond)}
+		s.visits[path] = v
+	}
+	v.count++
+	count := v.count
+	reset := v.window.Add(time.Second)
+	s.mu.Unlock()
+
+	remaining := s.rateLimit - count
+	if remaining < 0 {
+		remaining = 0
+	}
+	w.Header().Set("X-Bapi-Limit", strconv.Itoa(s.rateLimit))
+	w.Header().Set("X-Bapi-Limit-Status", strconv.Itoa(remaining))
+	w.Header().Set("X-Bapi-Limit-Reset-Timestamp", millis(reset))
+
+	if count > s.rateLimit {
+		writeError(w, now, 10006, "Too many visits!")
+		return true
+	}
+	return false
+}
+
+type response struct {
+	RetCode    int         `json:"retCode"`
+	RetMsg     string      `json:"retMsg"`
+	Result     interface{} `json:"result"`
+	RetExtInfo struct{}    `json:"retExtInfo"`
+	Time       int64       `json:"time"`
+}
+
+func writeResult(w http.ResponseWriter, now time.Time, result interface{}) {
+	writeResponse(w, http.StatusOK, response{RetMsg: "OK", Result: result, Time: now.UnixNano() / int64(time.Millisecond)})
+}
+
+func writeError(w http.ResponseWriter, now time.Time, retCode int, retMsg string) {
+	writeResponse(w, http.StatusOK, response{RetCode: retCode, RetMsg: retMsg, Result: struct{}{}, Time: now.UnixNano() / int64(time.Millisecond)})
+}
+
+func writeResponse(w http.ResponseWriter, status int, res response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(res)
+}
@@ -0,0 +1,455 @@
+package bybittest
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/sngyai/go-bybit"
+	"github.com/sngyai/go-bybit/ws/wsv5"
+)
+
+const (
+	publicPath  = wsv5.PublicPath
+	privatePath = wsv5.PrivatePath
+)
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(*http.Request) bool { return true },
+}
+
+type conn struct {
+	ws       *websocket.Conn
+	id       string
+	path     string
+	category bybit.CategoryV5
+
+	wmu sync.Mutex
+
+	// guarded by Server.mu
+	topics map[string]bool
+	authed bool
+}
+
+// write : with a deadline, so that a client which stopped reading cannot block the server
+func (c *conn) write(v interface{}) {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+
+	_ = c.ws.SetWriteDeadline(time.Now().Add(time.Second))
+	_ = c.ws.WriteMessage(websocket.TextMessage, buf)
+}
+
+type wsRequest struct {
+	ReqID string            `json:"req_id"`
+	Op    string            `json:"op"`
+	Args  []json.RawMessage `json:"args"`
+}
+
+type wsResponse struct {
+	Success bool   `json:"success"`
+	RetMsg  string `json:"ret_msg"`
+	ConnID  string `json:"conn_id"`
+	ReqID   string `json:"req_id,omitempty"`
+	Op      string `json:"op"`
+}
+
+func (s *Server) serveWebsocket(w http.ResponseWriter, r *http.Request) {
+	c := &conn{id: newID(), path: r.URL.Path, topics: map[string]bool{}}
+	if c.path != privatePath {
+		c.category = bybit.CategoryV5(strings.TrimPrefix(c.path, publicPath+"/"))
+		if !s.hasCategory(c.category) {
+			http.NotFound(w, r)
+			return
+		}
+	}
+	if f := s.takeFault(c.path, func(f *Fault) bool { return f.StatusCode >= http.StatusMultipleChoices }); f != nil {
+		f.write(w, s.now())
+		return
+	}
+
+	var err error
+	if c.ws, err = upgrader.Upgrade(w, r, nil); err != nil {
+		return
+	}
+	defer c.ws.Close()
+
+	s.mu.Lock()
+	s.conns[c] = struct{}{}
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, c)
+		s.mu.Unlock()
+	}()
+
+	for {
+		_, message, err := c.ws.ReadMessage()
+		if err != nil {
+			return
+		}
+		var req wsRequest
+		if err := json.Unmarshal(message, &req); err != nil {
+			c.write(wsResponse{RetMsg: "Invalid request: " + err.Error(), ConnID: c.id})
+			continue
+		}
+
+		switch req.Op {
+		case "ping":
+			c.write(wsResponse{Success: true, RetMsg: "pong", ConnID: c.id, ReqID: req.ReqID, Op: req.Op})
+		case "auth":
+			s.authWebsocket(c, req)
+		case "subscribe", "unsubscribe":
+			s.subscribeWebsocket(c, req)
+		default:
+			c.write(wsResponse{RetMsg: "Unrecognized request op: " + req.Op, ConnID: c.id, ReqID: req.ReqID, Op: req.Op})
+		}
+	}
+}
+
+func (s *Server) hasCategory(category bybit.CategoryV5) bool {
+	for _, instrument := range s.instruments {
+		if instrument.Category == category {
+			return true
+		}
+	}
+	return false
+}
+
+// authWebsocket : args are the key, the expiry in milliseconds and the signature of "GET/realtime" + expiry
+func (s *Server) authWebsocket(c *conn, req wsRequest) {
+	res := wsResponse{Success: true, ConnID: c.id, ReqID: req.ReqID, Op: req.Op}
+
+	if f := s.takeFault(privatePath, func(f *Fault) bool { return f.RetCode != 0 }); f != nil {
+		res.Success, res.RetMsg = false, f.RetMsg
+	} else if s.key != "" {
+		var key, signature string
+		var expires int64
+		if len(req.Args) != 3 ||
+			json.Unmarshal(req.Args[0], &key) != nil ||
+			json.Unmarshal(req.Args[1], &expires) != nil ||
+			json.Unmarshal(req.Args[2], &signature) != nil {
+			res.Success, res.RetMsg = false, "Params Error"
+		} else if key != s.key {
+			res.Success, res.RetMsg = false, "Invalid apikey"
+		} else if expires < s.now().UnixNano()/int64(time.Millisecond) {
+			res.Success, res.RetMsg = false, "Request expired"
+		} else if !s.validSignature("GET/realtime"+strconv.FormatInt(expires, 10), signature) {
+			res.Success, res.RetMsg = false, "Invalid sign"
+		}
+	}
+
+	s.mu.Lock()
+	c.authed = res.Success
+	s.mu.Unlock()
+
+	c.write(res)
+}
+
+// subscribeWebsocket : answers, then sends the snapshots of the new topics as the exchange does
+func (s *Server) subscribeWebsocket(c *conn, req wsRequest) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := wsResponse{Success: true, ConnID: c.id, ReqID: req.ReqID, Op: req.Op}
+	var added []string
+	for _, arg := range req.Args {
+		var topic string
+		if err := json.Unmarshal(arg, &topic); err != nil || !s.validTopicLocked(c, topic) {
+			res.Success = false
+			res.RetMsg = "Invalid topic: " + string(arg)
+			if c.path == privatePath && !c.authed {
+				res.RetMsg = "Request not authorized"
+			}
+			continue
+		}
+		if req.Op == "unsubscribe" {
+			delete(c.topics, topic)
+			continue
+		}
+		if !c.topics[topic] {
+			c.topics[topic] = true
+			added = append(added, topic)
+		}
+	}
+	c.write(res)
+
+	now := s.now()
+	for _, topic := range added {
+		kind, depth, symbol := parseTopic(topic)
+		key := marketKey{c.category, symbol}
+		switch kind {
+		case wsv5.PublicTopicOrderBook:
+			c.write(s.orderbookMessageLocked(topic, key, depth, now))
+		case wsv5.PublicTopicTickers:
+			c.write(s.tickerMessageLocked(topic, key, now))
+		}
+	}
+}
+
+func (s *Server) validTopicLocked(c *conn, topic string) bool {
+	if c.path == privatePath {
+		switch topic {
+		case wsv5.PrivateTopicOrder, wsv5.PrivateTopicPosition, wsv5.PrivateTopicWallet:
+			return c.authed
+		}
+		return false
+	}
+
+	kind, depth, symbol := parseTopic(topic)
+	if _, ok := s.books[marketKey{c.category, symbol}]; !ok {
+		return false
+	}
+	switch kind {
+	case wsv5.PublicTopicOrderBook:
+		return depth == 1 || depth == 50 || depth == 200 || depth == 500
+	case wsv5.PublicTopicTickers, wsv5.PublicTopicTrade:
+		return true
+	}
+	return false
+}
+
+// parseTopic : "orderbook.50.BTCUSDT", "tickers.BTCUSDT" or "publicTrade.BTCUSDT"
+func parseTopic(topic string) (string, int, bybit.SymbolV5) {
+	arr := strings.Split(topic, ".")
+	switch {
+	case len(arr) == 3 && arr[0] == wsv5.PublicTopicOrderBook:
+		depth, err := strconv.Atoi(arr[1])
+		if err != nil {
+			return "", 0, ""
+		}
+		return arr[0], depth, bybit.SymbolV5(arr[2])
+	case len(arr) == 2:
+		return arr[0], 0, bybit.SymbolV5(arr[1])
+	}
+	return "", 0, ""
+}
+
+func (s *Server) orderbookMessageLocked(topic string, key marketKey, depth int, now time.Time) map[string]interface{} {
+	b := s.books[key]
+	return map[string]interface{}{
+		"topic": topic,
+		"type":  "snapshot",
+		"ts":    now.UnixNano() / int64(time.Millisecond),
+		"data": map[string]interface{}{
+			"s":   key.symbol,
+			"b":   b.levels(bybit.SideBuy, depth),
+			"a":   b.levels(bybit.SideSell, depth),
+			"u":   b.updateID,
+			"seq": b.seq,
+		},
+	}
+}
+
+func (s *Server) tickerMessageLocked(topic string, key marketKey, now time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"topic": topic,
+		"type":  "snapshot",
+		"ts":    now.UnixNano() / int64(time.Millisecond),
+		"data":  s.tickerLocked(key),
+	}
+}
+
+// publishMarketLocked : the book changes since the last call, the tickers and the trades of fills.
+// orderbook.1 is sent as snapshots, the other depths as deltas
+func (s *Server) publishMarketLocked(key marketKey, fills []fill, now time.Time) {
+	b := s.books[key]
+	bids, asks := b.takeChanges(bybit.SideBuy), b.takeChanges(bybit.SideSell)
+	bookChanged := len(bids) > 0 || len(asks) > 0
+	if !bookChanged && len(fills) == 0 {
+		return
+	}
+	if bookChanged {
+		b.updateID++
+		b.seq++
+	}
+
+	ts := now.UnixNano() / int64(time.Millisecond)
+	trades := make([]map[string]interface{}, 0, len(fills))
+	for _, f := range fills {
+		trades = append(trades, map[string]interface{}{
+			"T":  ts,
+			"s":  key.symbol,
+			"S":  f.taker.side,
+			"v":  f.qty.String(),
+			"p":  f.price.String(),
+			"i":  newID(),
+			"BT": false,
+		})
+	}
+
+	path := publicPath + "/" + string(key.category)
+	for c := range s.conns {
+		if c.path != path {
+			continue
+		}
+		for topic := range c.topics {
+			kind, depth, symbol := parseTopic(topic)
+			if symbol != key.symbol {
+				continue
+			}
+			switch {
+			case kind == wsv5.PublicTopicOrderBook && bookChanged && depth == 1:
+				c.write(s.orderbookMessageLocked(topic, key, depth, now))
+			case kind == wsv5.PublicTopicOrderBook && bookChanged:
+				c.write(map[string]interface{}{
+					"topic": topic,
+					"type":  "delta",
+					"ts":    ts,
+					"data": map[string]interface{}{
+						"s":   key.symbol,
+						"b":   bids,
+						"a":   asks,
+						"u":   b.updateID,
+						"seq": b.seq,
+					},
+				})
+			case kind == wsv5.PublicTopicTickers:
+				c.write(s.tickerMessageLocked(topic, key, now))
+			case kind == wsv5.PublicTopicTrade && len(trades) > 0:
+				c.write(map[string]interface{}{
+					"topic": topic,
+					"type":  "snapshot",
+					"ts":    ts,
+					"data":  trades,
+				})
+			}
+		}
+	}
+}
+
+// publishPrivateLocked : to the authenticated private streams subscribed to topic
+func (s *Server) publishPrivateLocked(topic string, data interface{}, now time.Time) {
+	message := map[string]interface{}{
+		"id":           newID(),
+		"topic":        topic,
+		"creationTime": now.UnixNano() / int64(time.Millisecond),
+		"data":         data,
+	}
+	for c := range s.conns {
+		if c.path == privatePath && c.authed && c.topics[topic] {
+			c.write(message)
+		}
+	}
+}
+
+func (s *Server) publishOrdersLocked(orders []*order, now time.Time) {
+	if len(orders) == 0 {
+		return
+	}
+	data := make([]wsv5.PrivateOrderData, 0, len(orders))
+	for _, o := range orders {
+		data = append(data, wsv5.PrivateOrderData{
+			AvgPrice:     toDecimal(o.avgPrice()),
+			Category:     string(o.category),
+			CreatedTime:  millis(o.createdAt),
+			CumExecQty:   toDecimal(o.cumQty),
+			CumExecValue: toDecimal(o.cumValue),
+			LeavesQty:    toDecimal(o.leavesQty()),
+			LeavesValue:  toDecimal(o.leavesValue()),
+			OrderID:      o.id,
+			OrderStatus:  string(o.status),
+			OrderLinkID:  o.linkID,
+			OrderType:    o.orderType,
+			Price:        toDecimal(o.price),
+			Qty:          toDecimal(o.qty),
+			ReduceOnly:   o.reduceOnly,
+			RejectReason: o.reasonOrNone(),
+			Side:         o.side,
+			Symbol:       o.symbol,
+			TimeInForce:  o.timeInForce,
+			UpdatedTime:  millis(o.updatedAt),
+		})
+	}
+	s.publishPrivateLocked(wsv5.PrivateTopicOrder, data, now)
+}
+
+func (s *Server) publishPositionLocked(key marketKey, now time.Time) {
+	info := s.positionInfoLocked(key)
+	s.publishPrivateLocked(wsv5.PrivateTopicPosition, []wsv5.PrivatePositionData{{
+		TpSlMode:       info.TpSlMode,
+		Symbol:         info.Symbol,
+		Side:           info.Side,
+		Size:           info.Size,
+		EntryPrice:     info.AvgPrice,
+		Leverage:       info.Leverage,
+		PositionValue:  info.PositionValue,
+		MarkPrice:      info.MarkPrice,
+		UnrealisedPnl:  info.UnrealisedPnl,
+		CumRealisedPnl: info.CumRealisedPnl,
+		CreatedTime:    info.CreatedTime,
+		UpdatedTime:    info.UpdatedTime,
+		TpslMode:       info.TpSlMode,
+		Category:       key.category,
+		PositionStatus: info.PositionStatus,
+	}}, now)
+}
+
+func (s *Server) publishWalletLocked(now time.Time) {
+	wallet := s.walletLocked(nil)
+	data := wsv5.PrivateWalletData{
+		TotalEquity:           wallet.TotalEquity,
+		TotalWalletBalance:    wallet.TotalWalletBalance,
+		TotalMarginBalance:    wallet.TotalMarginBalance,
+		TotalAvailableBalance: wallet.TotalAvailableBalance,
+		TotalPerpUPL:          wallet.TotalPerpUPL,
+		AccountType:           bybit.AccountTypeUnified,
+	}
+	for _, coin := range wallet.Coin {
+		data.Coins = append(data.Coins, wsv5.PrivateWalletCoin{
+			Coin:                coin.Coin,
+			Equity:              coin.Equity,
+			UsdValue:            coin.UsdValue,
+			WalletBalance:       coin.WalletBalance,
+			AvailableToWithdraw: coin.AvailableToWithdraw,
+			UnrealisedPnl:       coin.UnrealisedPnl,
+			CumRealisedPnl:      coin.CumRealisedPnl,
+		})
+	}
+	s.publishPrivateLocked(wsv5.PrivateTopicWallet, []wsv5.PrivateWalletData{data}, now)
+}
+
+// Disconnect : drops the websocket connections of path without a close frame, as a network failure would.
+// "" for every connection
+func (s *Server) Disconnect(path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for c := range s.conns {
+		if path == "" || c.path == path {
+			_ = c.ws.UnderlyingConn().Close()
+		}
+	}
+}
+
+// Topics : subscribed by the connections of path, e.g. to wait for a client to resubscribe
+func (s *Server) Topics(path string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seen := map[string]bool{}
+	topics := []string{}
+	for c := range s.conns {
+		if c.path != path {
+			continue
+		}
+		for topic := range c.topics {
+			if !seen[topic] {
+				seen[topic] = true
+				topics = append(topics, topic)
+			}
+		}
+	}
+	sort.Strings(topics)
+	return topics
+}
@@ -0,0 +1,108 @@
+package bybit
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/shopspring/decimal"
+)
+
+// Decimal : arbitrary-precision number for prices, quantities and balances.
+//
+// Bybit encodes them as JSON strings, with "" for not applicable. Such an empty value
+// decodes to zero and reports IsEmpty, and is encoded back as "".
+// Arithmetic and rounding are available through Decimal(). The value is not embedded, so that methods
+// of decimal.Decimal such as UnmarshalText, Scan and IsZero do not bypass the handling of "", and an
+// explicit zero is not dropped by omitempty.
+type Decimal struct {
+	value decimal.Decimal
+	empty bool
+}
+
+// NewDecimal : parses s, "" gives an empty Decimal
+func NewDecimal(s string) (Decimal, error) {
+	if s == "" {
+		return Decimal{empty: true}, nil
+	}
+	d, err := decimal.NewFromString(s)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return Decimal{value: d}, nil
+}
+
+// MustDecimal : same as NewDecimal but panics on an invalid s, for constants
+func MustDecimal(s string) Decimal {
+	d, err := NewDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// DecimalFrom : wraps the result of decimal.Decimal arithmetic
+func DecimalFrom(d decimal.Decimal) Decimal {
+	return Decimal{value: d}
+}
+
+// Decimal : for arithmetic and rounding, zero when empty
+func (d Decimal) Decimal() decimal.Decimal {
+	return d.value
+}
+
+// IsEmpty : whether the value was encoded as "" or null
+func (d Decimal) IsEmpty() bool {
+	return d.empty
+}
+
+// String : "" for an empty Decimal
+func (d Decimal) String() string {
+	if d.empty {
+		return ""
+	}
+	return d.value.String()
+}
+
+// Ptr : for optional parameters
+func (d Decimal) Ptr() *Decimal {
+	return &d
+}
+
+// MarshalJSON : always a string, as Bybit expects
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + d.String() + `"`), nil
+}
+
+// UnmarshalJSON : accepts a string, an empty string, a number or null
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*d = Decimal{empty: true}
+		return nil
+	}
+
+	var s string
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	} else {
+		var n json.Number
+		if err := json.Unmarshal(data, &n); err != nil {
+			return err
+		}
+		s = n.String()
+	}
+
+	v, err := NewDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// EncodeValues : for query parameters
+func (d Decimal) EncodeValues(key string, v *url.Values) error {
+	v.Set(key, d.String())
+	return nil
+}
@@ -0,0 +1,159 @@
+package bybit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestDecimalUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		want      string
+		wantEmpty bool
+		wantErr   bool
+	}{
+		{name: "string", data: `"30000.5"`, want: "30000.5"},
+		{name: "number", data: `30000.5`, want: "30000.5"},
+		{name: "zero", data: `"0"`, want: "0"},
+		{name: "negative", data: `"-0.01"`, want: "-0.01"},
+		{name: "exponent", data: `1e-8`, want: "0.00000001"},
+		{name: "empty string", data: `""`, want: "", wantEmpty: true},
+		{name: "null", data: `null`, want: "", wantEmpty: true},
+		{name: "unterminated string", data: `"1`, wantErr: true},
+		{name: "unopened string", data: `1"`, wantErr: true},
+		{name: "doubly quoted", data: `""1""`, wantErr: true},
+		{name: "not a number", data: `"abc"`, wantErr: true},
+		{name: "bool", data: `true`, wantErr: true},
+		{name: "object", data: `{}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d Decimal
+			err := d.UnmarshalJSON([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := d.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+			if got := d.IsEmpty(); got != tt.wantEmpty {
+				t.Errorf("IsEmpty() = %v, want %v", got, tt.wantEmpty)
+			}
+		})
+	}
+}
+
+func TestDecimalJSONRoundTrip(t *testing.T) {
+	type params struct {
+		Price    Decimal  `json:"price"`
+		Qty      *Decimal `json:"qty,omitempty"`
+		Leverage *Decimal `json:"leverage,omitempty"`
+	}
+
+	tests := []struct {
+		name  string
+		value params
+		want  string
+	}{
+		{
+			name:  "values",
+			value: params{Price: MustDecimal("30000.5"), Qty: MustDecimal("0.01").Ptr()},
+			want:  `{"price":"30000.5","qty":"0.01"}`,
+		},
+		{
+			name:  "explicit zero",
+			value: params{Price: MustDecimal("0"), Qty: MustDecimal("0").Ptr()},
+			want:  `{"price":"0","qty":"0"}`,
+		},
+		{
+			name:  "empty",
+			value: params{Price: MustDecimal(""), Qty: MustDecimal("").Ptr()},
+			want:  `{"price":"","qty":""}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(buf) != tt.want {
+				t.Errorf("Marshal = %s, want %s", buf, tt.want)
+			}
+
+			var got params
+			if err := json.Unmarshal(buf, &got); err != nil {
+				t.Fatal(err)
+			}
+			if got.Price.String() != tt.value.Price.String() || got.Price.IsEmpty() != tt.value.Price.IsEmpty() {
+				t.Errorf("price = %q, want %q", got.Price, tt.value.Price)
+			}
+			if got.Qty == nil || got.Qty.String() != tt.value.Qty.String() || got.Qty.IsEmpty() != tt.value.Qty.IsEmpty() {
+				t.Errorf("qty = %v, want %q", got.Qty, tt.value.Qty)
+			}
+			if got.Leverage != nil {
+				t.Errorf("leverage = %q, want nil", got.Leverage)
+			}
+		})
+	}
+}
+
+func TestDecimalEncodeValues(t *testing.T) {
+	type params struct {
+		Price    Decimal  `url:"price,omitempty"`
+		Qty      *Decimal `url:"qty,omitempty"`
+		Leverage *Decimal `url:"leverage,omitempty"`
+	}
+
+	tests := []struct {
+		name  string
+		value params
+		want  string
+	}{
+		{
+			name:  "values",
+			value: params{Price: MustDecimal("30000.5"), Qty: MustDecimal("0.01").Ptr()},
+			want:  "price=30000.5&qty=0.01",
+		},
+		{
+			name:  "explicit zero",
+			value: params{Price: MustDecimal("0"), Qty: MustDecimal("0").Ptr()},
+			want:  "price=0&qty=0",
+		},
+		{
+			name:  "empty",
+			value: params{Price: MustDecimal(""), Qty: MustDecimal("").Ptr()},
+			want:  "price=&qty=",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := query.Values(tt.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := values.Encode(); got != tt.want {
+				t.Errorf("Encode = %s, want %s", got, tt.want)
+			}
+
+			for _, name := range []string{"price", "qty"} {
+				var got Decimal
+				if err := json.Unmarshal([]byte(`"`+values.Get(name)+`"`), &got); err != nil {
+					t.Fatal(err)
+				}
+				if want := MustDecimal(values.Get(name)); got.String() != want.String() || got.IsEmpty() != want.IsEmpty() {
+					t.Errorf("%s = %q, want %q", name, got, want)
+				}
+			}
+		})
+	}
+}
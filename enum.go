@@ -177,6 +177,10 @@ const (
 	ExecTypeFunding = ExecType("Funding")
 	// ExecTypeBustTrade :
 	ExecTypeBustTrade = ExecType("BustTrade")
+	// ExecTypeDelivery :
+	ExecTypeDelivery = ExecType("Delivery")
+	// ExecTypeBlockTrade :
+	ExecTypeBlockTrade = ExecType("BlockTrade")
 )
 
 // Direction :
@@ -4,8 +4,12 @@ package bybit
 type AccountType string
 
 const (
-	AccountTypeUnified AccountType = "UNIFIED"
-	AccountTypeNormal  AccountType = "CONTRACT"
+	AccountTypeUnified    AccountType = "UNIFIED"
+	AccountTypeNormal     AccountType = "CONTRACT"
+	AccountTypeSpot       AccountType = "SPOT"
+	AccountTypeFund       AccountType = "FUND"
+	AccountTypeOption     AccountType = "OPTION"
+	AccountTypeInvestment AccountType = "INVESTMENT"
 )
 
 // CategoryV5 :
@@ -101,6 +105,24 @@ const (
 	PositionIdxHedgeSell = PositionIdx(2)
 )
 
+// PositionMode : one-way mode uses PositionIdxOneWay, hedge mode uses PositionIdxHedgeBuy/PositionIdxHedgeSell
+type PositionMode int
+
+// PositionMode :
+const (
+	PositionModeMergedSingle = PositionMode(0)
+	PositionModeBothSides    = PositionMode(3)
+)
+
+// TradeModeV5 :
+type TradeModeV5 int
+
+// TradeModeV5 :
+const (
+	TradeModeV5Cross    = TradeModeV5(0)
+	TradeModeV5Isolated = TradeModeV5(1)
+)
+
 // ContractType :
 type ContractType string
 
@@ -149,3 +171,32 @@ const (
 	InnovationFalse = Innovation("0")
 	InnovationTrue  = Innovation("1")
 )
+
+// TransferStatusV5 :
+type TransferStatusV5 string
+
+// TransferStatusV5 :
+const (
+	TransferStatusV5Success = TransferStatusV5("SUCCESS")
+	TransferStatusV5Pending = TransferStatusV5("PENDING")
+	TransferStatusV5Failed  = TransferStatusV5("FAILED")
+)
+
+// WithdrawTypeV5 :
+type WithdrawTypeV5 int
+
+// WithdrawTypeV5 :
+const (
+	WithdrawTypeV5OnChain  = WithdrawTypeV5(0)
+	WithdrawTypeV5OffChain = WithdrawTypeV5(1)
+	WithdrawTypeV5All      = WithdrawTypeV5(2)
+)
+
+// MarginModeV5 :
+type MarginModeV5 string
+
+// MarginModeV5 :
+const (
+	MarginModeV5Regular   = MarginModeV5("REGULAR_MARGIN")
+	MarginModeV5Portfolio = MarginModeV5("PORTFOLIO_MARGIN")
+)
@@ -75,14 +75,14 @@ func main() {
 	log.Printf("positions: %#v\n", res1.Result)
 
 	// 创建委托
-	price := "23000.0"
+	price := bybit.MustDecimal("23000.0")
 	//timeInForce := bybit.TimeInForce("GoodTillCancel")
 	order, err := client.V5().Order().CreateOrder(rest.V5CreateOrderParam{
 		Category:  bybit.CategoryV5Spot,
 		Symbol:    symbol,
 		Side:      bybit.SideBuy,
 		OrderType: bybit.OrderTypeLimit,
-		Qty:       "0.01",
+		Qty:       bybit.MustDecimal("0.01"),
 		Price:     &price,
 		//TimeInForce: &timeInForce,
 	})
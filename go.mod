@@ -1,9 +1,10 @@
 module github.com/sngyai/go-bybit
 
-go 1.17
+go 1.18
 
 require (
 	github.com/google/go-querystring v1.1.0
 	github.com/gorilla/websocket v1.5.0
+	github.com/shopspring/decimal v1.4.0
 	golang.org/x/net v0.7.0
 )
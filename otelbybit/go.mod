@@ -0,0 +1,18 @@
+module github.com/sngyai/go-bybit/otelbybit
+
+go 1.19
+
+require (
+	github.com/sngyai/go-bybit v0.0.0-20261018103620-0efa6eccb02d
+	go.opentelemetry.io/otel v1.16.0
+	go.opentelemetry.io/otel/metric v1.16.0
+	go.opentelemetry.io/otel/trace v1.16.0
+)
+
+require (
+	github.com/go-logr/logr v1.2.4 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/google/go-querystring v1.1.0 // indirect
+	github.com/gorilla/websocket v1.5.0 // indirect
+	github.com/shopspring/decimal v1.4.0 // indirect
+)
@@ -0,0 +1,63 @@
+// Package otelbybit : OpenTelemetry tracing and metrics for the REST and websocket clients.
+//
+//	interceptor, err := otelbybit.NewRESTInterceptor()
+//	if err != nil {
+//		return err
+//	}
+//	client := rest.NewClient().WithInterceptor(interceptor)
+//
+//	observer, err := otelbybit.NewWSObserver()
+//	if err != nil {
+//		return err
+//	}
+//	wsClient := ws.NewWebsocketClient().WithObserver(observer)
+package otelbybit
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/sngyai/go-bybit/otelbybit"
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// Option :
+type Option func(*config)
+
+// WithTracerProvider : otel.GetTracerProvider() by default
+func WithTracerProvider(p trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = p
+	}
+}
+
+// WithMeterProvider : otel.GetMeterProvider() by default
+func WithMeterProvider(p metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = p
+	}
+}
+
+func newConfig(opts []Option) *config {
+	c := &config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+func (c *config) tracer() trace.Tracer {
+	return c.tracerProvider.Tracer(instrumentationName)
+}
+
+func (c *config) meter() metric.Meter {
+	return c.meterProvider.Meter(instrumentationName)
+}
@@ -0,0 +1,202 @@
+package otelbybit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sngyai/go-bybit/rest"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// attribute keys
+const (
+	AttributeEndpoint           = attribute.Key("bybit.endpoint")
+	AttributeCategory           = attribute.Key("bybit.category")
+	AttributeSymbol             = attribute.Key("bybit.symbol")
+	AttributeRetCode            = attribute.Key("bybit.ret_code")
+	AttributeRateLimit          = attribute.Key("bybit.rate_limit.limit")
+	AttributeRateLimitRemaining = attribute.Key("bybit.rate_limit.remaining")
+	AttributeHTTPMethod         = attribute.Key("http.method")
+	AttributeHTTPPath           = attribute.Key("http.path")
+	AttributeHTTPStatusCode     = attribute.Key("http.status_code")
+)
+
+type restSpan struct {
+	span  trace.Span
+	ctx   context.Context
+	start time.Time
+	attrs []attribute.KeyValue
+	ended bool
+}
+
+// restSpanKey : context key of the *restSpan of an attempt
+type restSpanKey struct{}
+
+type restInstrumentation struct {
+	tracer   trace.Tracer
+	duration metric.Float64Histogram
+	requests metric.Int64Counter
+}
+
+// NewRESTInterceptor : creates a span per attempt of each REST call, with the endpoint, category, symbol,
+// retCode and rate limit status, and records the bybit.rest.requests and bybit.rest.duration metrics
+func NewRESTInterceptor(opts ...Option) (rest.Interceptor, error) {
+	c := newConfig(opts)
+	meter := c.meter()
+
+	duration, err := meter.Float64Histogram(
+		"bybit.rest.duration",
+		metric.WithDescription("Latency of REST calls"),
+		metric.WithUnit("ms"),
+	)
+	if err != nil {
+		return rest.Interceptor{}, err
+	}
+	requests, err := meter.Int64Counter(
+		"bybit.rest.requests",
+		metric.WithDescription("Number of REST calls"),
+	)
+	if err != nil {
+		return rest.Interceptor{}, err
+	}
+
+	i := &restInstrumentation{
+		tracer:   c.tracer(),
+		duration: duration,
+		requests: requests,
+	}
+	return rest.Interceptor{
+		BeforeRequest: i.beforeRequest,
+		AfterResponse: i.afterResponse,
+		OnError:       i.onError,
+	}, nil
+}
+
+// beforeRequest : the span is carried to the other hooks by the returned context, and propagated
+// to the server by the headers of the global propagator
+func (i *restInstrumentation) beforeRequest(req *http.Request) (context.Context, error) {
+	endpoint := rest.EndpointFromContext(req.Context())
+	if endpoint == "" {
+		endpoint = req.URL.Path
+	}
+	attrs := []attribute.KeyValue{
+		AttributeEndpoint.String(endpoint),
+		AttributeHTTPMethod.String(req.Method),
+		AttributeHTTPPath.String(req.URL.Path),
+	}
+	category, symbol := categoryAndSymbol(req)
+	if category != "" {
+		attrs = append(attrs, AttributeCategory.String(category))
+	}
+	if symbol != "" {
+		attrs = append(attrs, AttributeSymbol.String(symbol))
+	}
+
+	ctx, span := i.tracer.Start(req.Context(), endpoint,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	s := &restSpan{span: span, ctx: ctx, start: time.Now(), attrs: attrs}
+	return context.WithValue(ctx, restSpanKey{}, s), nil
+}
+
+func (i *restInstrumentation) afterResponse(req *http.Request, resp *rest.InterceptedResponse) {
+	s := i.take(req)
+	if s == nil {
+		return
+	}
+
+	attrs := append(s.attrs[:len(s.attrs):len(s.attrs)],
+		AttributeHTTPStatusCode.Int(resp.StatusCode),
+		AttributeRetCode.Int(resp.Common.RetCode),
+	)
+	s.span.SetAttributes(attrs...)
+	if limit, err := strconv.Atoi(resp.Header.Get("X-Bapi-Limit")); err == nil {
+		s.span.SetAttributes(AttributeRateLimit.Int(limit))
+	}
+	if remaining, err := strconv.Atoi(resp.Header.Get("X-Bapi-Limit-Status")); err == nil {
+		s.span.SetAttributes(AttributeRateLimitRemaining.Int(remaining))
+	}
+
+	switch {
+	case resp.StatusCode < 200 || 299 < resp.StatusCode:
+		s.span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+	case resp.Common.RetCode != 0:
+		s.span.SetStatus(codes.Error, resp.Common.RetMsg)
+	}
+	i.end(s, attrs)
+}
+
+func (i *restInstrumentation) onError(req *http.Request, err error) {
+	// errors of a response, such as a non-zero retCode, have already been recorded by afterResponse
+	s := i.take(req)
+	if s == nil {
+		return
+	}
+
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+	i.end(s, s.attrs)
+}
+
+// take : the span of the attempt, nil once it has ended
+func (i *restInstrumentation) take(req *http.Request) *restSpan {
+	s, ok := req.Context().Value(restSpanKey{}).(*restSpan)
+	if !ok || s.ended {
+		return nil
+	}
+	s.ended = true
+	return s
+}
+
+// end : the symbol is left out of the metrics to bound their cardinality
+func (i *restInstrumentation) end(s *restSpan, attrs []attribute.KeyValue) {
+	metricAttrs := make([]attribute.KeyValue, 0, len(attrs))
+	for _, attr := range attrs {
+		if attr.Key != AttributeSymbol {
+			metricAttrs = append(metricAttrs, attr)
+		}
+	}
+
+	i.requests.Add(s.ctx, 1, metric.WithAttributes(metricAttrs...))
+	i.duration.Record(s.ctx, float64(time.Since(s.start))/float64(time.Millisecond), metric.WithAttributes(metricAttrs...))
+	s.span.End()
+}
+
+// categoryAndSymbol : from the query, or from the JSON or form body
+func categoryAndSymbol(req *http.Request) (string, string) {
+	values := req.URL.Query()
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			buf, _ := io.ReadAll(body)
+			_ = body.Close()
+
+			if strings.HasPrefix(req.Header.Get("Content-Type"), "application/json") {
+				var fields struct {
+					Category string `json:"category"`
+					Symbol   string `json:"symbol"`
+				}
+				if err := json.Unmarshal(buf, &fields); err == nil {
+					return fields.Category, fields.Symbol
+				}
+			} else if form, err := url.ParseQuery(string(buf)); err == nil {
+				values = form
+			}
+		}
+	}
+	return values.Get("category"), values.Get("symbol")
+}
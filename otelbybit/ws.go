@@ -0,0 +1,104 @@
+package otelbybit
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/sngyai/go-bybit/ws"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// attribute keys
+const (
+	AttributeWSPath   = attribute.Key("bybit.ws.path")
+	AttributeWSTopic  = attribute.Key("bybit.ws.topic")
+	AttributeWSReason = attribute.Key("bybit.ws.reason")
+	AttributeWSResult = attribute.Key("bybit.ws.result")
+)
+
+// NewWSObserver : creates a span per websocket session, and records the bybit.ws.messages,
+// bybit.ws.handler.duration, bybit.ws.dropped and bybit.ws.reconnects metrics.
+// As for the REST side, the symbol is left out of the metrics: only the topic prefix, e.g. orderbook, is recorded
+func NewWSObserver(opts ...Option) (ws.Observer, error) {
+	c := newConfig(opts)
+	tracer := c.tracer()
+	meter := c.meter()
+
+	messages, err := meter.Int64Counter(
+		"bybit.ws.messages",
+		metric.WithDescription("Number of websocket messages handled"),
+	)
+	if err != nil {
+		return ws.Observer{}, err
+	}
+	handlerDuration, err := meter.Float64Histogram(
+		"bybit.ws.handler.duration",
+		metric.WithDescription("Latency of the subscription handlers"),
+		metric.WithUnit("ms"),
+	)
+	if err != nil {
+		return ws.Observer{}, err
+	}
+	dropped, err := meter.Int64Counter(
+		"bybit.ws.dropped",
+		metric.WithDescription("Number of websocket messages no handler was called for"),
+	)
+	if err != nil {
+		return ws.Observer{}, err
+	}
+	reconnects, err := meter.Int64Counter(
+		"bybit.ws.reconnects",
+		metric.WithDescription("Number of websocket reconnections"),
+	)
+	if err != nil {
+		return ws.Observer{}, err
+	}
+
+	return ws.Observer{
+		SessionStarted: func(ctx context.Context, path string) func(error) {
+			_, span := tracer.Start(ctx, "bybit.ws.session "+path,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(AttributeWSPath.String(path)),
+			)
+			return func(err error) {
+				if err != nil {
+					span.RecordError(err)
+					span.SetStatus(codes.Error, err.Error())
+				}
+				span.End()
+			}
+		},
+		Reconnected: func(path string) {
+			reconnects.Add(context.Background(), 1, metric.WithAttributes(AttributeWSPath.String(path)))
+		},
+		MessageHandled: func(path string, topic string, latency time.Duration, err error) {
+			result := "ok"
+			if err != nil {
+				result = "error"
+			}
+			attrs := metric.WithAttributes(
+				AttributeWSPath.String(path),
+				AttributeWSTopic.String(topicPrefix(topic)),
+				AttributeWSResult.String(result),
+			)
+			messages.Add(context.Background(), 1, attrs)
+			handlerDuration.Record(context.Background(), float64(latency)/float64(time.Millisecond), attrs)
+		},
+		MessageDropped: func(path string, topic string, reason string) {
+			dropped.Add(context.Background(), 1, metric.WithAttributes(
+				AttributeWSPath.String(path),
+				AttributeWSTopic.String(topicPrefix(topic)),
+				AttributeWSReason.String(reason),
+			))
+		},
+	}, nil
+}
+
+// topicPrefix : orderbook for orderbook.50.BTCUSDT
+func topicPrefix(topic string) string {
+	return strings.SplitN(topic, ".", 2)[0]
+}
@@ -2,9 +2,7 @@ package rest
 
 import (
 	"bytes"
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/hex"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -15,6 +13,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/sngyai/go-bybit"
 )
 
 const (
@@ -31,9 +31,19 @@ type Client struct {
 
 	baseURL string
 	key     string
-	secret  string
+	signer  bybit.Signer
 
 	checkResponseBody checkResponseBodyFunc
+	rateLimiter       *RateLimiter
+	retryPolicy       *RetryPolicy
+
+	recvWindow time.Duration
+	clockSync  *ClockSync
+
+	instrumentRegistry *V5InstrumentRegistry
+	interceptors       []Interceptor
+
+	ctx context.Context
 }
 
 // NewClient :
@@ -42,6 +52,8 @@ func NewClient() *Client {
 		httpClient:        &http.Client{},
 		baseURL:           MainNetBaseURL,
 		checkResponseBody: checkResponseBody,
+		rateLimiter:       NewRateLimiter(),
+		recvWindow:        DefaultRecvWindow,
 	}
 }
 
@@ -52,10 +64,22 @@ func (c *Client) WithHTTPClient(httpClient *http.Client) *Client {
 	return c
 }
 
-// WithAuth :
+// WithAuth : signs with HMAC-SHA256 of the secret
 func (c *Client) WithAuth(key string, secret string) *Client {
+	return c.WithSigner(key, bybit.NewHMACSigner(secret))
+}
+
+// WithSigner : signs with signer, e.g. bybit.RSASigner for self-generated API keys
+func (c *Client) WithSigner(key string, signer bybit.Signer) *Client {
 	c.key = key
-	c.secret = secret
+	c.signer = signer
+
+	return c
+}
+
+// WithRateLimiter : shared by every service created from the client. nil disables client-side rate limiting
+func (c *Client) WithRateLimiter(l *RateLimiter) *Client {
+	c.rateLimiter = l
 
 	return c
 }
@@ -73,6 +97,26 @@ func (c Client) withCheckResponseBody(f checkResponseBodyFunc) *Client {
 	return &c
 }
 
+// WithContext : returns a copy of the client whose requests are bound to ctx,
+// so that every service created from it can be cancelled or time-boxed.
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//	res, err := client.WithContext(ctx).V5().Order().CreateOrder(param)
+func (c Client) WithContext(ctx context.Context) *Client {
+	c.ctx = ctx
+
+	return &c
+}
+
+// Context : context bound by WithContext, context.Background() otherwise
+func (c *Client) Context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
 // WithBaseURL :
 func (c *Client) WithBaseURL(url string) *Client {
 	c.baseURL = url
@@ -82,23 +126,74 @@ func (c *Client) WithBaseURL(url string) *Client {
 
 // Request :
 func (c *Client) Request(req *http.Request, dst interface{}) error {
+	if _, err := c.waitRateLimiter(req); err != nil {
+		c.onError(req, err)
+		return err
+	}
+	return c.send(req, dst)
+}
+
+// waitRateLimiter : reports whether it blocked
+func (c *Client) waitRateLimiter(req *http.Request) (bool, error) {
+	if c.rateLimiter == nil {
+		return false, nil
+	}
+	return c.rateLimiter.wait(req.Context(), req, c.now)
+}
+
+// send : runs the interceptors, which may rebind req to another context, then sends req past the rate limiter
+func (c *Client) send(req *http.Request, dst interface{}) error {
+	req, err := c.beforeRequest(req)
+	if err == nil {
+		err = c.request(req, dst)
+	}
+	if err != nil {
+		c.onError(req, err)
+		return err
+	}
+	return nil
+}
+
+func (c *Client) request(req *http.Request, dst interface{}) error {
+	sent := time.Now()
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
+	if c.rateLimiter != nil {
+		c.rateLimiter.Update(req, resp.Header)
+	}
+
 	switch {
 	case 200 <= resp.StatusCode && resp.StatusCode <= 299:
 		body, err := io.ReadAll(resp.Body)
 		if err != nil {
 			return err
 		}
+		c.afterResponse(req, resp, body, time.Since(sent))
+
+		if c.clockSync != nil {
+			c.clockSync.observe(body, sent, time.Now())
+		}
 
 		if c.checkResponseBody == nil {
 			return errors.New("checkResponseBody func should be set")
 		}
 		if err := c.checkResponseBody(body); err != nil {
+			var errorResponse *ErrorResponse
+			if errors.As(err, &errorResponse) {
+				errorResponse.StatusCode = resp.StatusCode
+				errorResponse.Endpoint = req.URL.Path
+			}
+			var rateLimitError *RateLimitError
+			if errors.As(err, &rateLimitError) {
+				rateLimitError.fillResetFromHeader(resp.Header)
+				if c.rateLimiter != nil {
+					c.rateLimiter.exhaust(req)
+				}
+			}
 			return err
 		}
 
@@ -106,23 +201,30 @@ func (c *Client) Request(req *http.Request, dst interface{}) error {
 			return err
 		}
 		return nil
-	case resp.StatusCode == http.StatusForbidden:
-		return ErrAccessDenied
-	case resp.StatusCode == http.StatusNotFound:
-		return ErrPathNotFound
 	default:
-		return errors.New("unexpected error")
+		body, _ := io.ReadAll(resp.Body)
+		c.afterResponse(req, resp, body, time.Since(sent))
+		switch resp.StatusCode {
+		case http.StatusForbidden:
+			return ErrAccessDenied
+		case http.StatusNotFound:
+			return ErrPathNotFound
+		}
+		return &UnexpectedStatusError{
+			StatusCode: resp.StatusCode,
+			Endpoint:   req.URL.Path,
+			Body:       body,
+		}
 	}
 }
 
-// hasAuth : check has auth key and secret
+// hasAuth : check has auth key and signer
 func (c *Client) hasAuth() bool {
-	return c.key != "" && c.secret != ""
+	return c.key != "" && c.signer != nil
 }
 
-func (c *Client) populateSignature(src url.Values) url.Values {
-	intNow := int(time.Now().UTC().UnixNano() / int64(time.Millisecond))
-	now := strconv.Itoa(intNow)
+func (c *Client) populateSignature(src url.Values) (url.Values, error) {
+	now := strconv.Itoa(c.timestamp())
 
 	if src == nil {
 		src = url.Values{}
@@ -130,14 +232,17 @@ func (c *Client) populateSignature(src url.Values) url.Values {
 
 	src.Add("api_key", c.key)
 	src.Add("timestamp", now)
-	src.Add("sign", getSignature(src, c.secret))
+	sign, err := c.signer.Sign(getSignaturePayload(src))
+	if err != nil {
+		return nil, err
+	}
+	src.Add("sign", sign)
 
-	return src
+	return src, nil
 }
 
-func (c *Client) populateSignatureForBody(src []byte) []byte {
-	intNow := int(time.Now().UTC().UnixNano() / int64(time.Millisecond))
-	now := strconv.Itoa(intNow)
+func (c *Client) populateSignatureForBody(src []byte) ([]byte, error) {
+	now := strconv.Itoa(c.timestamp())
 
 	body := map[string]interface{}{}
 	if err := json.Unmarshal(src, &body); err != nil {
@@ -146,43 +251,43 @@ func (c *Client) populateSignatureForBody(src []byte) []byte {
 
 	body["api_key"] = c.key
 	body["timestamp"] = now
-	body["sign"] = getSignatureForBody(body, c.secret)
+	sign, err := c.signer.Sign(getSignaturePayloadForBody(body))
+	if err != nil {
+		return nil, err
+	}
+	body["sign"] = sign
 
 	result, err := json.Marshal(body)
 	if err != nil {
 		panic(err)
 	}
 
-	return result
+	return result, nil
 }
 
-func getV5Signature(
+func getV5SignaturePayload(
 	timestamp int,
 	key string,
+	recvWindow string,
 	queryString string,
-	secret string,
-) string {
-	val := strconv.Itoa(timestamp) + key
+) []byte {
+	val := strconv.Itoa(timestamp) + key + recvWindow
 	val = val + queryString
-	h := hmac.New(sha256.New, []byte(secret))
-	h.Write([]byte(val))
-	return hex.EncodeToString(h.Sum(nil))
+	return []byte(val)
 }
 
-func getV5SignatureForBody(
+func getV5SignaturePayloadForBody(
 	timestamp int,
 	key string,
+	recvWindow string,
 	body []byte,
-	secret string,
-) string {
-	val := strconv.Itoa(timestamp) + key
+) []byte {
+	val := strconv.Itoa(timestamp) + key + recvWindow
 	val = val + string(body)
-	h := hmac.New(sha256.New, []byte(secret))
-	h.Write([]byte(val))
-	return hex.EncodeToString(h.Sum(nil))
+	return []byte(val)
 }
 
-func getSignature(src url.Values, key string) string {
+func getSignaturePayload(src url.Values) []byte {
 	keys := make([]string, len(src))
 	i := 0
 	_val := ""
@@ -195,16 +300,11 @@ func getSignature(src url.Values, key string) string {
 		_val += k + "=" + src.Get(k) + "&"
 	}
 	_val = _val[0 : len(_val)-1]
-	h := hmac.New(sha256.New, []byte(key))
-	_, err := io.WriteString(h, _val)
-	if err != nil {
-		panic(err)
-	}
 
-	return fmt.Sprintf("%x", h.Sum(nil))
+	return []byte(_val)
 }
 
-func getSignatureForBody(src map[string]interface{}, key string) string {
+func getSignaturePayloadForBody(src map[string]interface{}) []byte {
 	keys := make([]string, len(src))
 	i := 0
 	_val := ""
@@ -217,196 +317,185 @@ func getSignatureForBody(src map[string]interface{}, key string) string {
 		_val += k + "=" + fmt.Sprintf("%v", src[k]) + "&"
 	}
 	_val = _val[0 : len(_val)-1]
-	h := hmac.New(sha256.New, []byte(key))
-	_, err := io.WriteString(h, _val)
-	if err != nil {
-		panic(err)
-	}
 
-	return fmt.Sprintf("%x", h.Sum(nil))
+	return []byte(_val)
 }
 
 func (c *Client) getPublicly(path string, query url.Values, dst interface{}) error {
-	u, err := url.Parse(c.baseURL)
-	if err != nil {
-		return err
-	}
-	u.Path = path
-	u.RawQuery = query.Encode()
-
-	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
-	if err != nil {
-		return err
-	}
-
-	if err := c.Request(req, &dst); err != nil {
-		return err
-	}
+	return c.do(true, func() (*http.Request, error) {
+		u, err := url.Parse(c.baseURL)
+		if err != nil {
+			return nil, err
+		}
+		u.Path = path
+		u.RawQuery = query.Encode()
 
-	return nil
+		return http.NewRequestWithContext(c.Context(), http.MethodGet, u.String(), nil)
+	}, dst)
 }
 
 func (c *Client) getPrivately(path string, query url.Values, dst interface{}) error {
 	if !c.hasAuth() {
-		return fmt.Errorf("this is private endpoint, please set api key and secret")
+		return fmt.Errorf("this is private endpoint, please set api key and secret or signer")
 	}
 
-	u, err := url.Parse(c.baseURL)
-	if err != nil {
-		return err
-	}
-	u.Path = path
-	query = c.populateSignature(query)
-	u.RawQuery = query.Encode()
-
-	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
-	if err != nil {
-		return err
-	}
-
-	if err := c.Request(req, &dst); err != nil {
-		return err
-	}
+	return c.do(true, func() (*http.Request, error) {
+		u, err := url.Parse(c.baseURL)
+		if err != nil {
+			return nil, err
+		}
+		u.Path = path
+		query, err := c.populateSignature(cloneValues(query))
+		if err != nil {
+			return nil, err
+		}
+		u.RawQuery = query.Encode()
 
-	return nil
+		return http.NewRequestWithContext(c.Context(), http.MethodGet, u.String(), nil)
+	}, dst)
 }
 
 func (c *Client) getV5Privately(path string, query url.Values, dst interface{}) error {
 	if !c.hasAuth() {
-		return fmt.Errorf("this is private endpoint, please set api key and secret")
+		return fmt.Errorf("this is private endpoint, please set api key and secret or signer")
 	}
 
-	u, err := url.Parse(c.baseURL)
-	if err != nil {
-		return err
-	}
-	u.Path = path
-	u.RawQuery = query.Encode()
-
-	timestamp := int(time.Now().UTC().UnixNano() / int64(time.Millisecond))
-	sign := getV5Signature(timestamp, c.key, query.Encode(), c.secret)
+	return c.do(true, func() (*http.Request, error) {
+		u, err := url.Parse(c.baseURL)
+		if err != nil {
+			return nil, err
+		}
+		u.Path = path
+		u.RawQuery = query.Encode()
 
-	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
-	if err != nil {
-		return err
-	}
-	req.Header.Set("X-BAPI-API-KEY", c.key)
-	req.Header.Set("X-BAPI-TIMESTAMP", strconv.Itoa(timestamp))
-	req.Header.Set("X-BAPI-SIGN", sign)
+		timestamp := c.timestamp()
+		recvWindow := c.recvWindowString()
+		sign, err := c.signer.Sign(getV5SignaturePayload(timestamp, c.key, recvWindow, query.Encode()))
+		if err != nil {
+			return nil, err
+		}
 
-	if err := c.Request(req, &dst); err != nil {
-		return err
-	}
+		req, err := http.NewRequestWithContext(c.Context(), http.MethodGet, u.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("X-BAPI-API-KEY", c.key)
+		req.Header.Set("X-BAPI-TIMESTAMP", strconv.Itoa(timestamp))
+		req.Header.Set("X-BAPI-SIGN", sign)
+		if recvWindow != "" {
+			req.Header.Set(headerRecvWindow, recvWindow)
+		}
 
-	return nil
+		return req, nil
+	}, dst)
 }
 
 func (c *Client) postJSON(path string, body []byte, dst interface{}) error {
 	if !c.hasAuth() {
-		return fmt.Errorf("this is private endpoint, please set api key and secret")
-	}
-
-	u, err := url.Parse(c.baseURL)
-	if err != nil {
-		return err
+		return fmt.Errorf("this is private endpoint, please set api key and secret or signer")
 	}
-	u.Path = path
 
-	body = c.populateSignatureForBody(body)
+	return c.do(isRetryableOrderCreate(path, jsonKeys(body)), func() (*http.Request, error) {
+		u, err := url.Parse(c.baseURL)
+		if err != nil {
+			return nil, err
+		}
+		u.Path = path
 
-	req, err := http.NewRequest(http.MethodPost, u.String(), bytes.NewBuffer(body))
-	if err != nil {
-		return err
-	}
-	req.Header.Set("Content-Type", "application/json")
+		signedBody, err := c.populateSignatureForBody(body)
+		if err != nil {
+			return nil, err
+		}
 
-	if err := c.Request(req, &dst); err != nil {
-		return err
-	}
+		req, err := http.NewRequestWithContext(c.Context(), http.MethodPost, u.String(), bytes.NewBuffer(signedBody))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
 
-	return nil
+		return req, nil
+	}, dst)
 }
 
 func (c *Client) postV5JSON(path string, body []byte, dst interface{}) error {
 	if !c.hasAuth() {
-		return fmt.Errorf("this is private endpoint, please set api key and secret")
-	}
-
-	u, err := url.Parse(c.baseURL)
-	if err != nil {
-		return err
+		return fmt.Errorf("this is private endpoint, please set api key and secret or signer")
 	}
-	u.Path = path
 
-	timestamp := int(time.Now().UTC().UnixNano() / int64(time.Millisecond))
-	sign := getV5SignatureForBody(timestamp, c.key, body, c.secret)
+	return c.do(isRetryableOrderCreate(path, jsonKeys(body)), func() (*http.Request, error) {
+		u, err := url.Parse(c.baseURL)
+		if err != nil {
+			return nil, err
+		}
+		u.Path = path
 
-	req, err := http.NewRequest(http.MethodPost, u.String(), bytes.NewBuffer(body))
-	if err != nil {
-		return err
-	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("X-BAPI-API-KEY", c.key)
-	req.Header.Set("X-BAPI-TIMESTAMP", strconv.Itoa(timestamp))
-	req.Header.Set("X-BAPI-SIGN", sign)
+		timestamp := c.timestamp()
+		recvWindow := c.recvWindowString()
+		sign, err := c.signer.Sign(getV5SignaturePayloadForBody(timestamp, c.key, recvWindow, body))
+		if err != nil {
+			return nil, err
+		}
 
-	if err := c.Request(req, &dst); err != nil {
-		return err
-	}
+		req, err := http.NewRequestWithContext(c.Context(), http.MethodPost, u.String(), bytes.NewBuffer(body))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-BAPI-API-KEY", c.key)
+		req.Header.Set("X-BAPI-TIMESTAMP", strconv.Itoa(timestamp))
+		req.Header.Set("X-BAPI-SIGN", sign)
+		if recvWindow != "" {
+			req.Header.Set(headerRecvWindow, recvWindow)
+		}
 
-	return nil
+		return req, nil
+	}, dst)
 }
 
 func (c *Client) postForm(path string, body url.Values, dst interface{}) error {
 	if !c.hasAuth() {
-		return fmt.Errorf("this is private endpoint, please set api key and secret")
+		return fmt.Errorf("this is private endpoint, please set api key and secret or signer")
 	}
 
-	u, err := url.Parse(c.baseURL)
-	if err != nil {
-		return nil
-	}
-	u.Path = path
-
-	body = c.populateSignature(body)
+	return c.do(isRetryableOrderCreate(path, valuesKeys(body)), func() (*http.Request, error) {
+		u, err := url.Parse(c.baseURL)
+		if err != nil {
+			return nil, err
+		}
+		u.Path = path
 
-	req, err := http.NewRequest(http.MethodPost, u.String(), strings.NewReader(body.Encode()))
-	if err != nil {
-		return err
-	}
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	if err != nil {
-		return err
-	}
+		form, err := c.populateSignature(cloneValues(body))
+		if err != nil {
+			return nil, err
+		}
 
-	if err := c.Request(req, &dst); err != nil {
-		return err
-	}
+		req, err := http.NewRequestWithContext(c.Context(), http.MethodPost, u.String(), strings.NewReader(form.Encode()))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
-	return nil
+		return req, nil
+	}, dst)
 }
 
 func (c *Client) deletePrivately(path string, query url.Values, dst interface{}) error {
 	if !c.hasAuth() {
-		return fmt.Errorf("this is private endpoint, please set api key and secret")
-	}
-
-	u, err := url.Parse(c.baseURL)
-	if err != nil {
-		return err
+		return fmt.Errorf("this is private endpoint, please set api key and secret or signer")
 	}
-	u.Path = path
-	query = c.populateSignature(query)
-	u.RawQuery = query.Encode()
 
-	req, err := http.NewRequest(http.MethodDelete, u.String(), nil)
-	if err != nil {
-		return err
-	}
-
-	if err := c.Request(req, &dst); err != nil {
-		return err
-	}
+	return c.do(false, func() (*http.Request, error) {
+		u, err := url.Parse(c.baseURL)
+		if err != nil {
+			return nil, err
+		}
+		u.Path = path
+		query, err := c.populateSignature(cloneValues(query))
+		if err != nil {
+			return nil, err
+		}
+		u.RawQuery = query.Encode()
 
-	return nil
+		return http.NewRequestWithContext(c.Context(), http.MethodDelete, u.String(), nil)
+	}, dst)
 }
@@ -0,0 +1,177 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultRecvWindow : how long after its timestamp a signed request is accepted by the server
+	DefaultRecvWindow = 5 * time.Second
+
+	headerRecvWindow = "X-BAPI-RECV-WINDOW"
+)
+
+// WithRecvWindow : sent as X-BAPI-RECV-WINDOW with V5 private requests. 0 leaves it to the server default
+func (c *Client) WithRecvWindow(d time.Duration) *Client {
+	c.recvWindow = d
+
+	return c
+}
+
+// WithClockSync : signs requests with the server-corrected time of s
+//
+//	clock := rest.NewClockSync(client)
+//	if err := clock.Start(ctx, time.Minute); err != nil {
+//		return err
+//	}
+//	client.WithClockSync(clock)
+func (c *Client) WithClockSync(s *ClockSync) *Client {
+	c.clockSync = s
+
+	return c
+}
+
+// now : local time corrected by the clock sync if set
+func (c *Client) now() time.Time {
+	if c.clockSync == nil {
+		return time.Now()
+	}
+	return c.clockSync.Now()
+}
+
+// timestamp : in milliseconds, as the signatures expect
+func (c *Client) timestamp() int {
+	return int(c.now().UnixNano() / int64(time.Millisecond))
+}
+
+// recvWindowString : empty when no recv window is configured
+func (c *Client) recvWindowString() string {
+	if c.recvWindow <= 0 {
+		return ""
+	}
+	return strconv.FormatInt(int64(c.recvWindow/time.Millisecond), 10)
+}
+
+// ClockSync : keeps track of the offset between the local clock and the server clock,
+// so that signed requests are not rejected on hosts with drifting clocks.
+//
+// The offset is measured against /v5/market/time by Sync, and refined passively from the
+// time field of V5 responses seen by a client using it.
+// It satisfies ws.Clock, so the same instance can be shared with ws.WebSocketClient.
+type ClockSync struct {
+	client *Client
+
+	mu       sync.RWMutex
+	offset   time.Duration
+	rtt      time.Duration // round trip of the sample the offset was taken from
+	syncedAt time.Time
+	maxAge   time.Duration
+}
+
+// NewClockSync : client is only used for the public server time endpoint
+func NewClockSync(client *Client) *ClockSync {
+	return &ClockSync{
+		client: client,
+		maxAge: time.Minute,
+	}
+}
+
+// Now : local time corrected by the measured offset
+func (s *ClockSync) Now() time.Time {
+	return time.Now().Add(s.Offset())
+}
+
+// Offset : server time minus local time
+func (s *ClockSync) Offset() time.Duration {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.offset
+}
+
+// SyncedAt : when the offset was last updated. Zero before the first sync
+func (s *ClockSync) SyncedAt() time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.syncedAt
+}
+
+// Sync : measures the offset against the server time endpoint
+func (s *ClockSync) Sync(ctx context.Context) error {
+	sent := time.Now()
+	res, err := s.client.WithContext(ctx).V5().Market().GetServerTime()
+	if err != nil {
+		return err
+	}
+	received := time.Now()
+
+	nano, err := strconv.ParseInt(res.Result.TimeNano, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	s.set(time.Unix(0, nano), sent, received, true)
+	return nil
+}
+
+// Start : syncs once, then keeps refreshing every interval until ctx is done.
+// A failed refresh keeps the previous offset
+func (s *ClockSync) Start(ctx context.Context, interval time.Duration) error {
+	if err := s.Sync(ctx); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.maxAge = interval
+	s.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				_ = s.Sync(ctx)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// observe : takes the time field of a V5 response as a sample
+func (s *ClockSync) observe(body []byte, sent, received time.Time) {
+	var common struct {
+		Time int64 `json:"time"`
+	}
+	if err := json.Unmarshal(body, &common); err != nil || common.Time == 0 {
+		return
+	}
+
+	s.set(time.Unix(0, common.Time*int64(time.Millisecond)), sent, received, false)
+}
+
+// set : the server is assumed to have stamped its time halfway through the round trip.
+// Passive samples only replace a fresh offset when they were taken with a shorter round trip
+func (s *ClockSync) set(server, sent, received time.Time, force bool) {
+	rtt := received.Sub(sent)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !force && !s.syncedAt.IsZero() && rtt > s.rtt && time.Since(s.syncedAt) < s.maxAge {
+		return
+	}
+
+	s.offset = server.Sub(sent.Add(rtt / 2))
+	s.rtt = rtt
+	s.syncedAt = received
+}
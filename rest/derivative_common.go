@@ -102,7 +102,7 @@ func (s *DerivativeCommonService) DerivativesOrderBook(param DerivativesOrderBoo
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/derivatives/v3/public/order-book/L2", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("DerivativeCommon.DerivativesOrderBook").getPublicly("/derivatives/v3/public/order-book/L2", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -178,7 +178,7 @@ func (s *DerivativeCommonService) DerivativesKline(param DerivativesKlineParam)
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/derivatives/v3/public/kline", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("DerivativeCommon.DerivativesKline").getPublicly("/derivatives/v3/public/kline", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -242,7 +242,7 @@ func (s *DerivativeCommonService) DerivativesTickers(param DerivativesTickersPar
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/derivatives/v3/public/tickers", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("DerivativeCommon.DerivativesTickers").getPublicly("/derivatives/v3/public/tickers", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -302,7 +302,7 @@ func (s *DerivativeCommonService) DerivativesTickersForOption(param DerivativesT
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/derivatives/v3/public/tickers", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("DerivativeCommon.DerivativesTickersForOption").getPublicly("/derivatives/v3/public/tickers", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -369,7 +369,7 @@ func (s *DerivativeCommonService) DerivativesInstruments(param DerivativesInstru
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/derivatives/v3/public/instruments-info", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("DerivativeCommon.DerivativesInstruments").getPublicly("/derivatives/v3/public/instruments-info", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -427,7 +427,7 @@ func (s *DerivativeCommonService) DerivativesInstrumentsForOption(param Derivati
 	}
 	queryString.Add("category", string(bybit.CategoryDerivativeOption))
 
-	if err := s.client.getPublicly("/derivatives/v3/public/instruments-info", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("DerivativeCommon.DerivativesInstrumentsForOption").getPublicly("/derivatives/v3/public/instruments-info", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -495,7 +495,7 @@ func (s *DerivativeCommonService) DerivativesMarkPriceKline(param DerivativesMar
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/derivatives/v3/public/mark-price-kline", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("DerivativeCommon.DerivativesMarkPriceKline").getPublicly("/derivatives/v3/public/mark-price-kline", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -563,7 +563,7 @@ func (s *DerivativeCommonService) DerivativesIndexPriceKline(param DerivativesIn
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/derivatives/v3/public/index-price-kline", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("DerivativeCommon.DerivativesIndexPriceKline").getPublicly("/derivatives/v3/public/index-price-kline", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -44,7 +44,7 @@ func (s *FutureCommonService) APIKeyInfo() (*APIKeyInfoResponse, error) {
 		query url.Values
 	)
 
-	if err := s.client.getPrivately("/v2/private/account/api-key", query, &res); err != nil {
+	if err := s.client.withEndpoint("FutureCommon.APIKeyInfo").getPrivately("/v2/private/account/api-key", query, &res); err != nil {
 		return nil, err
 	}
 
@@ -98,7 +98,7 @@ func (s *FutureCommonService) Balance(coin bybit.Coin) (*BalanceResponse, error)
 
 	query := url.Values{}
 	query.Add("coin", string(coin))
-	if err := s.client.getPrivately("/v2/private/wallet/balance", query, &res); err != nil {
+	if err := s.client.withEndpoint("FutureCommon.Balance").getPrivately("/v2/private/wallet/balance", query, &res); err != nil {
 		return nil, err
 	}
 
@@ -126,7 +126,7 @@ func (s *FutureCommonService) OrderBook(symbol bybit.SymbolFuture) (*OrderBookRe
 	query := url.Values{}
 	query.Add("symbol", string(symbol))
 
-	if err := s.client.getPublicly("/v2/public/orderBook/L2", query, &res); err != nil {
+	if err := s.client.withEndpoint("FutureCommon.OrderBook").getPublicly("/v2/public/orderBook/L2", query, &res); err != nil {
 		return nil, err
 	}
 
@@ -170,7 +170,7 @@ func (s *FutureCommonService) ListKline(param ListKlineParam) (*ListKlineRespons
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v2/public/kline/list", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("FutureCommon.ListKline").getPublicly("/v2/public/kline/list", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -217,7 +217,7 @@ func (s *FutureCommonService) Tickers(symbol bybit.SymbolFuture) (*TickersRespon
 	query := url.Values{}
 	query.Add("symbol", string(symbol))
 
-	if err := s.client.getPublicly("/v2/public/tickers", query, &res); err != nil {
+	if err := s.client.withEndpoint("FutureCommon.Tickers").getPublicly("/v2/public/tickers", query, &res); err != nil {
 		return nil, err
 	}
 
@@ -257,7 +257,7 @@ func (s *FutureCommonService) TradingRecords(param TradingRecordsParam) (*Tradin
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v2/public/trading-records", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("FutureCommon.TradingRecords").getPublicly("/v2/public/trading-records", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -308,7 +308,7 @@ type LotSizeFilter struct {
 func (s *FutureCommonService) Symbols() (*SymbolsResponse, error) {
 	var res SymbolsResponse
 
-	if err := s.client.getPublicly("/v2/public/symbols", nil, &res); err != nil {
+	if err := s.client.withEndpoint("FutureCommon.Symbols").getPublicly("/v2/public/symbols", nil, &res); err != nil {
 		return nil, err
 	}
 
@@ -350,7 +350,7 @@ func (s *FutureCommonService) MarkPriceKline(param MarkPriceKlineParam) (*MarkPr
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v2/public/mark-price-kline", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("FutureCommon.MarkPriceKline").getPublicly("/v2/public/mark-price-kline", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -392,7 +392,7 @@ func (s *FutureCommonService) IndexPriceKline(param IndexPriceKlineParam) (*Inde
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v2/public/index-price-kline", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("FutureCommon.IndexPriceKline").getPublicly("/v2/public/index-price-kline", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -429,7 +429,7 @@ func (s *FutureCommonService) OpenInterest(param OpenInterestParam) (*OpenIntere
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v2/public/open-interest", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("FutureCommon.OpenInterest").getPublicly("/v2/public/open-interest", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -466,7 +466,7 @@ func (s *FutureCommonService) BigDeal(param BigDealParam) (*BigDealResponse, err
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v2/public/big-deal", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("FutureCommon.BigDeal").getPublicly("/v2/public/big-deal", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -504,7 +504,7 @@ func (s *FutureCommonService) AccountRatio(param AccountRatioParam) (*AccountRat
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v2/public/account-ratio", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("FutureCommon.AccountRatio").getPublicly("/v2/public/account-ratio", queryString, &res); err != nil {
 		return nil, err
 	}
 	return &res, nil
@@ -111,7 +111,7 @@ func (s *FutureInverseFutureService) CreateFuturesOrder(param CreateFuturesOrder
 		return nil, fmt.Errorf("json marshal for CreateFuturesOrderParam: %w", err)
 	}
 
-	if err := s.client.postJSON("/futures/private/order/create", body, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInverseFuture.CreateFuturesOrder").postJSON("/futures/private/order/create", body, &res); err != nil {
 		return nil, err
 	}
 
@@ -176,7 +176,7 @@ func (s *FutureInverseFutureService) ListFuturesOrder(param ListFuturesOrderPara
 		return nil, err
 	}
 
-	if err := s.client.getPrivately("/futures/private/order/list", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInverseFuture.ListFuturesOrder").getPrivately("/futures/private/order/list", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -229,7 +229,7 @@ func (s *FutureInverseFutureService) CancelFuturesOrder(param CancelFuturesOrder
 		return nil, fmt.Errorf("json marshal for CancelFuturesOrderParam: %w", err)
 	}
 
-	if err := s.client.postJSON("/futures/private/order/cancel", body, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInverseFuture.CancelFuturesOrder").postJSON("/futures/private/order/cancel", body, &res); err != nil {
 		return nil, err
 	}
 
@@ -277,7 +277,7 @@ func (s *FutureInverseFutureService) CancelAllFuturesOrder(param CancelAllFuture
 		return nil, fmt.Errorf("json marshal for CancelAllFuturesOrderParam: %w", err)
 	}
 
-	if err := s.client.postJSON("/futures/private/order/cancelAll", body, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInverseFuture.CancelAllFuturesOrder").postJSON("/futures/private/order/cancelAll", body, &res); err != nil {
 		return nil, err
 	}
 
@@ -337,7 +337,7 @@ func (s *FutureInverseFutureService) QueryFuturesOrder(param QueryFuturesOrderPa
 		return nil, err
 	}
 
-	if err := s.client.getPrivately("/futures/private/order", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInverseFuture.QueryFuturesOrder").getPrivately("/futures/private/order", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -406,7 +406,7 @@ func (s *FutureInverseFutureService) CreateFuturesStopOrder(param CreateFuturesS
 		return nil, fmt.Errorf("json marshal for CreateFuturesStopOrderParam: %w", err)
 	}
 
-	if err := s.client.postJSON("/futures/private/stop-order/create", body, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInverseFuture.CreateFuturesStopOrder").postJSON("/futures/private/stop-order/create", body, &res); err != nil {
 		return nil, err
 	}
 
@@ -469,7 +469,7 @@ func (s *FutureInverseFutureService) ListFuturesStopOrder(param ListFuturesStopO
 		return nil, err
 	}
 
-	if err := s.client.getPrivately("/futures/private/stop-order/list", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInverseFuture.ListFuturesStopOrder").getPrivately("/futures/private/stop-order/list", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -504,7 +504,7 @@ func (s *FutureInverseFutureService) CancelFuturesStopOrder(param CancelFuturesS
 		return nil, fmt.Errorf("json marshal for CancelFuturesStopOrderParam: %w", err)
 	}
 
-	if err := s.client.postJSON("/futures/private/stop-order/cancel", body, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInverseFuture.CancelFuturesStopOrder").postJSON("/futures/private/stop-order/cancel", body, &res); err != nil {
 		return nil, err
 	}
 
@@ -556,7 +556,7 @@ func (s *FutureInverseFutureService) CancelAllFuturesStopOrder(param CancelAllFu
 		return nil, fmt.Errorf("json marshal for CancelAllFuturesStopOrderParam: %w", err)
 	}
 
-	if err := s.client.postJSON("/futures/private/stop-order/cancelAll", body, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInverseFuture.CancelAllFuturesStopOrder").postJSON("/futures/private/stop-order/cancelAll", body, &res); err != nil {
 		return nil, err
 	}
 
@@ -617,7 +617,7 @@ func (s *FutureInverseFutureService) QueryFuturesStopOrder(param QueryFuturesSto
 		return nil, err
 	}
 
-	if err := s.client.getPrivately("/futures/private/stop-order", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInverseFuture.QueryFuturesStopOrder").getPrivately("/futures/private/stop-order", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -681,7 +681,7 @@ func (s *FutureInverseFutureService) ListFuturesPositions(symbol bybit.SymbolFut
 	query := url.Values{}
 	query.Add("symbol", string(symbol))
 
-	if err := s.client.getPrivately("/futures/private/position/list", query, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInverseFuture.ListFuturesPositions").getPrivately("/futures/private/position/list", query, &res); err != nil {
 		return nil, err
 	}
 
@@ -753,7 +753,7 @@ func (s *FutureInverseFutureService) FuturesTradingStop(param FuturesTradingStop
 		return nil, fmt.Errorf("json marshal for FuturesTradingStopParam: %w", err)
 	}
 
-	if err := s.client.postJSON("/futures/private/position/trading-stop", body, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInverseFuture.FuturesTradingStop").postJSON("/futures/private/position/trading-stop", body, &res); err != nil {
 		return nil, err
 	}
 
@@ -782,7 +782,7 @@ func (s *FutureInverseFutureService) FuturesSaveLeverage(param FuturesSaveLevera
 		return nil, fmt.Errorf("json marshal for FuturesSaveLeverageParam: %w", err)
 	}
 
-	if err := s.client.postJSON("/futures/private/position/leverage/save", body, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInverseFuture.FuturesSaveLeverage").postJSON("/futures/private/position/leverage/save", body, &res); err != nil {
 		return nil, err
 	}
 
@@ -87,7 +87,7 @@ func (s *FutureInversePerpetualService) PremiumIndexKline(param PremiumIndexKlin
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v2/public/premium-index-kline", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInversePerpetual.PremiumIndexKline").getPublicly("/v2/public/premium-index-kline", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -153,7 +153,7 @@ func (s *FutureInversePerpetualService) CreateOrder(param CreateOrderParam) (*Cr
 		return nil, fmt.Errorf("json marshal for CreateOrderParam: %w", err)
 	}
 
-	if err := s.client.postJSON("/v2/private/order/create", body, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInversePerpetual.CreateOrder").postJSON("/v2/private/order/create", body, &res); err != nil {
 		return nil, err
 	}
 
@@ -215,7 +215,7 @@ func (s *FutureInversePerpetualService) ListOrder(param ListOrderParam) (*ListOr
 		return nil, err
 	}
 
-	if err := s.client.getPrivately("/v2/private/order/list", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInversePerpetual.ListOrder").getPrivately("/v2/private/order/list", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -271,7 +271,7 @@ func (s *FutureInversePerpetualService) ListPosition(symbol bybit.SymbolFuture)
 	query := url.Values{}
 	query.Add("symbol", string(symbol))
 
-	if err := s.client.getPrivately("/v2/private/position/list", query, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInversePerpetual.ListPosition").getPrivately("/v2/private/position/list", query, &res); err != nil {
 		return nil, err
 	}
 
@@ -294,7 +294,7 @@ type ListPositionsResult struct {
 func (s *FutureInversePerpetualService) ListPositions() (*ListPositionsResponse, error) {
 	var res ListPositionsResponse
 
-	if err := s.client.getPrivately("/v2/private/position/list", nil, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInversePerpetual.ListPositions").getPrivately("/v2/private/position/list", nil, &res); err != nil {
 		return nil, err
 	}
 
@@ -365,7 +365,7 @@ func (s *FutureInversePerpetualService) TradingStop(param TradingStopParam) (*Tr
 		return nil, fmt.Errorf("json marshal for TradingStopParam: %w", err)
 	}
 
-	if err := s.client.postJSON("/v2/private/position/trading-stop", body, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInversePerpetual.TradingStop").postJSON("/v2/private/position/trading-stop", body, &res); err != nil {
 		return nil, err
 	}
 
@@ -428,7 +428,7 @@ func (s *FutureInversePerpetualService) CancelOrder(param CancelOrderParam) (*Ca
 		return nil, fmt.Errorf("json marshal for CancelOrderParam: %w", err)
 	}
 
-	if err := s.client.postJSON("/v2/private/order/cancel", body, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInversePerpetual.CancelOrder").postJSON("/v2/private/order/cancel", body, &res); err != nil {
 		return nil, err
 	}
 
@@ -477,7 +477,7 @@ func (s *FutureInversePerpetualService) CancelAllOrder(param CancelAllOrderParam
 		return nil, fmt.Errorf("json marshal for CancelAllOrderParam: %w", err)
 	}
 
-	if err := s.client.postJSON("/v2/private/order/cancelAll", body, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInversePerpetual.CancelAllOrder").postJSON("/v2/private/order/cancelAll", body, &res); err != nil {
 		return nil, err
 	}
 
@@ -537,7 +537,7 @@ func (s *FutureInversePerpetualService) QueryOrder(param QueryOrderParam) (*Quer
 		return nil, err
 	}
 
-	if err := s.client.getPrivately("/v2/private/order", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInversePerpetual.QueryOrder").getPrivately("/v2/private/order", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -605,7 +605,7 @@ func (s *FutureInversePerpetualService) CreateStopOrder(param CreateStopOrderPar
 		return nil, fmt.Errorf("json marshal for CreateStopOrderParam: %w", err)
 	}
 
-	if err := s.client.postJSON("/v2/private/stop-order/create", body, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInversePerpetual.CreateStopOrder").postJSON("/v2/private/stop-order/create", body, &res); err != nil {
 		return nil, err
 	}
 
@@ -668,7 +668,7 @@ func (s *FutureInversePerpetualService) ListStopOrder(param ListStopOrderParam)
 		return nil, err
 	}
 
-	if err := s.client.getPrivately("/v2/private/stop-order/list", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInversePerpetual.ListStopOrder").getPrivately("/v2/private/stop-order/list", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -707,7 +707,7 @@ func (s *FutureInversePerpetualService) CancelStopOrder(param CancelStopOrderPar
 		return nil, fmt.Errorf("json marshal for CancelStopOrderParam: %w", err)
 	}
 
-	if err := s.client.postJSON("/v2/private/stop-order/cancel", body, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInversePerpetual.CancelStopOrder").postJSON("/v2/private/stop-order/cancel", body, &res); err != nil {
 		return nil, err
 	}
 
@@ -760,7 +760,7 @@ func (s *FutureInversePerpetualService) CancelAllStopOrder(param CancelAllStopOr
 		return nil, fmt.Errorf("json marshal for CancelAllStopOrderParam: %w", err)
 	}
 
-	if err := s.client.postJSON("/v2/private/stop-order/cancelAll", body, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInversePerpetual.CancelAllStopOrder").postJSON("/v2/private/stop-order/cancelAll", body, &res); err != nil {
 		return nil, err
 	}
 
@@ -821,7 +821,7 @@ func (s *FutureInversePerpetualService) QueryStopOrder(param QueryStopOrderParam
 		return nil, err
 	}
 
-	if err := s.client.getPrivately("/v2/private/stop-order", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInversePerpetual.QueryStopOrder").getPrivately("/v2/private/stop-order", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -849,7 +849,7 @@ func (s *FutureInversePerpetualService) SaveLeverage(param SaveLeverageParam) (*
 		return nil, fmt.Errorf("json marshal for CancelOrderParam: %w", err)
 	}
 
-	if err := s.client.postJSON("/v2/private/position/leverage/save", body, &res); err != nil {
+	if err := s.client.withEndpoint("FutureInversePerpetual.SaveLeverage").postJSON("/v2/private/position/leverage/save", body, &res); err != nil {
 		return nil, err
 	}
 
@@ -89,7 +89,7 @@ func (s *FutureCommonService) ListLinearKline(param ListLinearKlineParam) (*List
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/public/linear/kline", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("FutureCommon.ListLinearKline").getPublicly("/public/linear/kline", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -161,7 +161,7 @@ func (s *FutureUSDTPerpetualService) CreateLinearOrder(param CreateLinearOrderPa
 		return nil, fmt.Errorf("json marshal for CreateLinearOrderParam: %w", err)
 	}
 
-	if err := s.client.postJSON("/private/linear/order/create", body, &res); err != nil {
+	if err := s.client.withEndpoint("FutureUSDTPerpetual.CreateLinearOrder").postJSON("/private/linear/order/create", body, &res); err != nil {
 		return nil, err
 	}
 
@@ -227,7 +227,7 @@ func (s *FutureUSDTPerpetualService) ListLinearOrder(param ListLinearOrderParam)
 		return nil, err
 	}
 
-	if err := s.client.getPrivately("/private/linear/order/list", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("FutureUSDTPerpetual.ListLinearOrder").getPrivately("/private/linear/order/list", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -271,7 +271,7 @@ func (s *FutureUSDTPerpetualService) ListLinearPosition(symbol bybit.SymbolFutur
 	query := url.Values{}
 	query.Add("symbol", string(symbol))
 
-	if err := s.client.getPrivately("/private/linear/position/list", query, &res); err != nil {
+	if err := s.client.withEndpoint("FutureUSDTPerpetual.ListLinearPosition").getPrivately("/private/linear/position/list", query, &res); err != nil {
 		return nil, err
 	}
 
@@ -294,7 +294,7 @@ type ListLinearPositionsResult struct {
 func (s *FutureUSDTPerpetualService) ListLinearPositions() (*ListLinearPositionsResponse, error) {
 	var res ListLinearPositionsResponse
 
-	if err := s.client.getPrivately("/private/linear/position/list", nil, &res); err != nil {
+	if err := s.client.withEndpoint("FutureUSDTPerpetual.ListLinearPositions").getPrivately("/private/linear/position/list", nil, &res); err != nil {
 		return nil, err
 	}
 
@@ -338,7 +338,7 @@ func (s *FutureUSDTPerpetualService) CancelLinearOrder(param CancelLinearOrderPa
 		return nil, fmt.Errorf("json marshal for CancelLinearOrderParam: %w", err)
 	}
 
-	if err := s.client.postJSON("/private/linear/order/cancel", body, &res); err != nil {
+	if err := s.client.withEndpoint("FutureUSDTPerpetual.CancelLinearOrder").postJSON("/private/linear/order/cancel", body, &res); err != nil {
 		return nil, err
 	}
 
@@ -366,7 +366,7 @@ func (s *FutureUSDTPerpetualService) SaveLinearLeverage(param SaveLinearLeverage
 		return nil, fmt.Errorf("json marshal for SaveLinearLeverageParam: %w", err)
 	}
 
-	if err := s.client.postJSON("/private/linear/position/set-leverage", body, &res); err != nil {
+	if err := s.client.withEndpoint("FutureUSDTPerpetual.SaveLinearLeverage").postJSON("/private/linear/position/set-leverage", body, &res); err != nil {
 		return nil, err
 	}
 
@@ -402,7 +402,7 @@ func (s *FutureUSDTPerpetualService) LinearTradingStop(param LinearTradingStopPa
 		return nil, fmt.Errorf("json marshal for LinearTradingStopParam: %w", err)
 	}
 
-	if err := s.client.postJSON("/private/linear/position/trading-stop", body, &res); err != nil {
+	if err := s.client.withEndpoint("FutureUSDTPerpetual.LinearTradingStop").postJSON("/private/linear/position/trading-stop", body, &res); err != nil {
 		return nil, err
 	}
 
@@ -462,7 +462,7 @@ func (s *FutureUSDTPerpetualService) LinearExecutionList(param LinearExecutionLi
 		return nil, err
 	}
 
-	if err := s.client.getPrivately("/private/linear/trade/execution/list", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("FutureUSDTPerpetual.LinearExecutionList").getPrivately("/private/linear/trade/execution/list", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -491,7 +491,7 @@ func (s *FutureUSDTPerpetualService) LinearCancelAllOrder(param LinearCancelAllP
 		return &res, fmt.Errorf("json marshal for LinearCancelAllParam: %w", err)
 	}
 
-	if err := s.client.postJSON("/private/linear/order/cancel-all", body, &res); err != nil {
+	if err := s.client.withEndpoint("FutureUSDTPerpetual.LinearCancelAllOrder").postJSON("/private/linear/order/cancel-all", body, &res); err != nil {
 		return &res, err
 	}
 
@@ -532,7 +532,7 @@ func (s *FutureUSDTPerpetualService) ReplaceLinearOrder(param ReplaceLinearOrder
 		return nil, fmt.Errorf("json marshal for ReplaceLinearOrderResult: %w", err)
 	}
 
-	if err := s.client.postJSON("/private/linear/order/replace", body, &res); err != nil {
+	if err := s.client.withEndpoint("FutureUSDTPerpetual.ReplaceLinearOrder").postJSON("/private/linear/order/replace", body, &res); err != nil {
 		return nil, err
 	}
 
@@ -588,7 +588,7 @@ func (s *FutureUSDTPerpetualService) QueryLinearOrder(param QueryLinearOrderPara
 		return nil, err
 	}
 
-	if err := s.client.getPrivately("/private/linear/order/search", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("FutureUSDTPerpetual.QueryLinearOrder").getPrivately("/private/linear/order/search", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -658,7 +658,7 @@ func (s *FutureUSDTPerpetualService) CreateLinearStopOrder(param CreateLinearSto
 		return nil, fmt.Errorf("json marshal for CreateLinearStopOrderParam: %w", err)
 	}
 
-	if err := s.client.postJSON("/private/linear/stop-order/create", body, &res); err != nil {
+	if err := s.client.withEndpoint("FutureUSDTPerpetual.CreateLinearStopOrder").postJSON("/private/linear/stop-order/create", body, &res); err != nil {
 		return nil, err
 	}
 
@@ -724,7 +724,7 @@ func (s *FutureUSDTPerpetualService) ListLinearStopOrder(param ListLinearStopOrd
 		return nil, err
 	}
 
-	if err := s.client.getPrivately("/private/linear/stop-order/list", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("FutureUSDTPerpetual.ListLinearStopOrder").getPrivately("/private/linear/stop-order/list", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -763,7 +763,7 @@ func (s *FutureUSDTPerpetualService) CancelLinearStopOrder(param CancelLinearSto
 		return nil, fmt.Errorf("json marshal for CancelLinearStopOrderParam: %w", err)
 	}
 
-	if err := s.client.postJSON("/private/linear/stop-order/cancel", body, &res); err != nil {
+	if err := s.client.withEndpoint("FutureUSDTPerpetual.CancelLinearStopOrder").postJSON("/private/linear/stop-order/cancel", body, &res); err != nil {
 		return nil, err
 	}
 
@@ -793,7 +793,7 @@ func (s *FutureUSDTPerpetualService) CancelAllLinearStopOrder(param CancelAllLin
 		return nil, fmt.Errorf("json marshal for CancelAllLinearStopOrderParam: %w", err)
 	}
 
-	if err := s.client.postJSON("/private/linear/stop-order/cancel-all", body, &res); err != nil {
+	if err := s.client.withEndpoint("FutureUSDTPerpetual.CancelAllLinearStopOrder").postJSON("/private/linear/stop-order/cancel-all", body, &res); err != nil {
 		return nil, err
 	}
 
@@ -848,7 +848,7 @@ func (s *FutureUSDTPerpetualService) QueryLinearStopOrder(param QueryLinearStopO
 		return nil, err
 	}
 
-	if err := s.client.getPrivately("/private/linear/stop-order/search", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("FutureUSDTPerpetual.QueryLinearStopOrder").getPrivately("/private/linear/stop-order/search", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,137 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// Interceptor : hooks around every request sent by the client, for logging, audit, metrics,
+// fault injection or header injection. Any of the funcs may be nil.
+//
+// The hooks are called for each attempt, so a retried request is seen several times.
+type Interceptor struct {
+	// BeforeRequest : called right before the request is sent, after it has been signed.
+	// Headers may be added, but changing the query or body invalidates the signature.
+	// A non-nil context replaces the one of the request, e.g. to carry a span, and the later
+	// hooks are called with the request bound to it. Returning an error aborts the request with it
+	BeforeRequest func(req *http.Request) (context.Context, error)
+
+	// AfterResponse : called once the response has been read, before its retCode is checked
+	AfterResponse func(req *http.Request, resp *InterceptedResponse)
+
+	// OnError : called with the error the request is about to return
+	OnError func(req *http.Request, err error)
+}
+
+// InterceptedResponse :
+type InterceptedResponse struct {
+	Endpoint   string // Logical name of the service call, e.g. V5Order.CreateOrder. The request path for Client.Request and DoV5
+	Path       string // Request path, e.g. /v5/order/create
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+	Duration   time.Duration // From sending the request until the body was read
+
+	// Common : decoded from the body. For the legacy API, only RetCode and RetMsg are filled.
+	// Zero for a non-2xx status
+	Common CommonV5Response
+}
+
+// endpointKey : context key of the logical name of a service call
+type endpointKey struct{}
+
+// withEndpoint : returns a copy of the client whose requests carry the logical name of the service call
+func (c Client) withEndpoint(name string) *Client {
+	c.ctx = context.WithValue(c.Context(), endpointKey{}, name)
+
+	return &c
+}
+
+// EndpointFromContext : logical name of the service call a request was sent by, e.g. V5Order.CreateOrder,
+// from the context of the request passed to the hooks. Empty for Client.Request and DoV5
+func EndpointFromContext(ctx context.Context) string {
+	name, _ := ctx.Value(endpointKey{}).(string)
+	return name
+}
+
+// WithInterceptor : appends i to the chain. Interceptors are called in the order they were added
+func (c *Client) WithInterceptor(i Interceptor) *Client {
+	interceptors := make([]Interceptor, 0, len(c.interceptors)+1)
+	interceptors = append(interceptors, c.interceptors...)
+	c.interceptors = append(interceptors, i)
+
+	return c
+}
+
+// beforeRequest : returns req bound to the context the interceptors returned, even on error
+func (c *Client) beforeRequest(req *http.Request) (*http.Request, error) {
+	for _, i := range c.interceptors {
+		if i.BeforeRequest == nil {
+			continue
+		}
+		ctx, err := i.BeforeRequest(req)
+		if ctx != nil {
+			req = req.WithContext(ctx)
+		}
+		if err != nil {
+			return req, err
+		}
+	}
+	return req, nil
+}
+
+func (c *Client) afterResponse(req *http.Request, resp *http.Response, body []byte, duration time.Duration) {
+	if len(c.interceptors) == 0 {
+		return
+	}
+
+	endpoint := EndpointFromContext(req.Context())
+	if endpoint == "" {
+		endpoint = req.URL.Path
+	}
+	intercepted := &InterceptedResponse{
+		Endpoint:   endpoint,
+		Path:       req.URL.Path,
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       body,
+		Duration:   duration,
+	}
+	if 200 <= resp.StatusCode && resp.StatusCode <= 299 {
+		intercepted.Common = decodeCommonResponse(body)
+	}
+
+	for _, i := range c.interceptors {
+		if i.AfterResponse != nil {
+			i.AfterResponse(req, intercepted)
+		}
+	}
+}
+
+func (c *Client) onError(req *http.Request, err error) {
+	for _, i := range c.interceptors {
+		if i.OnError != nil {
+			i.OnError(req, err)
+		}
+	}
+}
+
+// decodeCommonResponse : falls back on the snake_case fields of the legacy API
+func decodeCommonResponse(body []byte) CommonV5Response {
+	var common CommonV5Response
+	if err := json.Unmarshal(body, &common); err == nil && (common.RetMsg != "" || common.RetCode != 0) {
+		return common
+	}
+
+	var legacy struct {
+		RetCode int    `json:"ret_code"`
+		RetMsg  string `json:"ret_msg"`
+	}
+	if err := json.Unmarshal(body, &legacy); err == nil {
+		common.RetCode = legacy.RetCode
+		common.RetMsg = legacy.RetMsg
+	}
+	return common
+}
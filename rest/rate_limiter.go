@@ -0,0 +1,154 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrRateLimitExceeded : a fail-fast RateLimiter refused a request which would be rejected by the exchange
+var ErrRateLimitExceeded = errors.New("rate limit exceeded")
+
+const (
+	headerRateLimit       = "X-Bapi-Limit"
+	headerRateLimitStatus = "X-Bapi-Limit-Status"
+	headerRateLimitReset  = "X-Bapi-Limit-Reset-Timestamp"
+
+	// rateLimitWindow : of the V5 limits, which are per second
+	rateLimitWindow = time.Second
+)
+
+// RateLimiter : client-side limiter which learns per endpoint group limits from the
+// X-Bapi-Limit, X-Bapi-Limit-Status and X-Bapi-Limit-Reset-Timestamp response headers.
+//
+// Each group is a token bucket whose capacity is X-Bapi-Limit, whose tokens are
+// X-Bapi-Limit-Status and which refills at X-Bapi-Limit-Reset-Timestamp, then every second after it
+// until the next response tells otherwise. The reset timestamps are server time, so a client with a
+// ClockSync compares them with its corrected time. A group nothing has been learned about yet is never limited.
+type RateLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]*rateLimitBucket
+
+	failFast  bool
+	groupFunc func(*http.Request) string
+	now       func() time.Time
+}
+
+type rateLimitBucket struct {
+	limit     int
+	remaining int
+	resetAt   time.Time
+}
+
+// NewRateLimiter : blocks until the bucket refills by default
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		buckets:   map[string]*rateLimitBucket{},
+		groupFunc: func(req *http.Request) string { return req.URL.Path },
+		now:       time.Now,
+	}
+}
+
+// WithFailFast : return ErrRateLimitExceeded instead of blocking
+func (l *RateLimiter) WithFailFast() *RateLimiter {
+	l.failFast = true
+
+	return l
+}
+
+// WithGroupFunc : decides which bucket a request belongs to, the request path by default
+func (l *RateLimiter) WithGroupFunc(f func(*http.Request) string) *RateLimiter {
+	l.groupFunc = f
+
+	return l
+}
+
+// Wait : takes a token for req's group, blocking until the bucket refills or ctx is done
+func (l *RateLimiter) Wait(ctx context.Context, req *http.Request) error {
+	_, err := l.wait(ctx, req, l.now)
+	return err
+}
+
+// wait : reports whether it blocked. now is the server time as seen by the caller
+func (l *RateLimiter) wait(ctx context.Context, req *http.Request, now func() time.Time) (bool, error) {
+	group := l.groupFunc(req)
+
+	for waited := false; ; waited = true {
+		wait, ok := l.take(group, now())
+		if ok {
+			return waited, nil
+		}
+		if l.failFast {
+			return waited, fmt.Errorf("%w: %s, retry after %s", ErrRateLimitExceeded, group, wait)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return waited, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// take : returns how long to wait when no token is available
+func (l *RateLimiter) take(group string, now time.Time) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, exist := l.buckets[group]
+	if !exist {
+		return 0, true
+	}
+
+	if !now.Before(b.resetAt) {
+		// the window moves on, as no response has told the next reset yet
+		b.remaining = b.limit
+		b.resetAt = b.resetAt.Add((now.Sub(b.resetAt)/rateLimitWindow + 1) * rateLimitWindow)
+	}
+	if b.remaining > 0 {
+		b.remaining--
+		return 0, true
+	}
+	return b.resetAt.Sub(now), false
+}
+
+// Update : learns the limit of req's group from the response headers
+func (l *RateLimiter) Update(req *http.Request, header http.Header) {
+	limit, err := strconv.Atoi(header.Get(headerRateLimit))
+	if err != nil {
+		return
+	}
+	remaining, err := strconv.Atoi(header.Get(headerRateLimitStatus))
+	if err != nil {
+		return
+	}
+	resetMs, err := strconv.ParseInt(header.Get(headerRateLimitReset), 10, 64)
+	if err != nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.buckets[l.groupFunc(req)] = &rateLimitBucket{
+		limit:     limit,
+		remaining: remaining,
+		resetAt:   time.UnixMilli(resetMs),
+	}
+}
+
+// exhaust : the exchange rejected req's group, no token is left until the known reset
+func (l *RateLimiter) exhaust(req *http.Request) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if b, exist := l.buckets[l.groupFunc(req)]; exist {
+		b.remaining = 0
+	}
+}
@@ -0,0 +1,98 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterTake(t *testing.T) {
+	base := time.UnixMilli(1700000000000)
+
+	tests := []struct {
+		name      string
+		limit     int
+		remaining int
+		takes     []time.Duration // since base
+		wantOK    []bool
+		wantWait  []time.Duration
+	}{
+		{
+			name:      "tokens left",
+			limit:     2,
+			remaining: 2,
+			takes:     []time.Duration{0, 0, 0},
+			wantOK:    []bool{true, true, false},
+			wantWait:  []time.Duration{0, 0, time.Second},
+		},
+		{
+			name:      "refills at the reset",
+			limit:     1,
+			remaining: 0,
+			takes:     []time.Duration{500 * time.Millisecond, time.Second},
+			wantOK:    []bool{false, true},
+			wantWait:  []time.Duration{500 * time.Millisecond, 0},
+		},
+		{
+			name:      "window moves on after the refill",
+			limit:     2,
+			remaining: 0,
+			takes:     []time.Duration{time.Second, 1100 * time.Millisecond, 1200 * time.Millisecond, 2 * time.Second},
+			wantOK:    []bool{true, true, false, true},
+			wantWait:  []time.Duration{0, 0, 800 * time.Millisecond, 0},
+		},
+		{
+			name:      "window moves past several seconds",
+			limit:     1,
+			remaining: 0,
+			takes:     []time.Duration{3500 * time.Millisecond, 3600 * time.Millisecond},
+			wantOK:    []bool{true, false},
+			wantWait:  []time.Duration{0, 400 * time.Millisecond},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewRateLimiter()
+			l.buckets["group"] = &rateLimitBucket{
+				limit:     tt.limit,
+				remaining: tt.remaining,
+				resetAt:   base.Add(time.Second),
+			}
+			for i, since := range tt.takes {
+				wait, ok := l.take("group", base.Add(since))
+				if ok != tt.wantOK[i] || wait != tt.wantWait[i] {
+					t.Errorf("take %d = %s, %v, want %s, %v", i, wait, ok, tt.wantWait[i], tt.wantOK[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimiterWaitUsesServerTime(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://api.bybit.com/v5/order/realtime", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	local := time.Now()
+	server := local.Add(time.Hour)
+	header := http.Header{}
+	header.Set(headerRateLimit, "1")
+	header.Set(headerRateLimitStatus, "0")
+	header.Set(headerRateLimitReset, strconv.FormatInt(server.Add(-time.Millisecond).UnixMilli(), 10))
+
+	l := NewRateLimiter().WithFailFast()
+	l.Update(req, header)
+
+	// against the local clock, the reset is an hour away
+	if err := l.Wait(context.Background(), req); !errors.Is(err, ErrRateLimitExceeded) {
+		t.Errorf("got error %v, want %v", err, ErrRateLimitExceeded)
+	}
+	// against the server clock, it has passed
+	if _, err := l.wait(context.Background(), req, func() time.Time { return server }); err != nil {
+		t.Errorf("got error %v with the server time", err)
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -16,19 +19,15 @@ func checkResponseBody(body []byte) error {
 	}
 
 	switch {
-	case commonResponse.RetCode == 10006:
-		rateLimitError := &RateLimitError{}
-		if err := json.Unmarshal(body, rateLimitError); err != nil {
-			return err
-		}
-		return rateLimitError
-	case commonResponse.RetCode != 0:
-		return &ErrorResponse{
-			RetCode: commonResponse.RetCode,
-			RetMsg:  commonResponse.RetMsg,
+	case commonResponse.RetCode == 0:
+		return nil
+	case isRateLimitRetCode(commonResponse.RetCode):
+		return &RateLimitError{
+			CommonResponse: &commonResponse,
+			Response:       newErrorResponse(commonResponse.RetCode, commonResponse.RetMsg, commonResponse.ExtInfo, body),
 		}
 	default:
-		return nil
+		return newErrorResponse(commonResponse.RetCode, commonResponse.RetMsg, commonResponse.ExtInfo, body)
 	}
 }
 
@@ -38,15 +37,7 @@ func checkV3ResponseBody(body []byte) error {
 		return err
 	}
 
-	switch {
-	case commonResponse.RetCode != 0:
-		return &ErrorResponse{
-			RetCode: commonResponse.RetCode,
-			RetMsg:  commonResponse.RetMsg,
-		}
-	default:
-		return nil
-	}
+	return checkRetCode(commonResponse.RetCode, commonResponse.RetMsg, commonResponse.RetExtInfo, body)
 }
 
 func checkV5ResponseBody(body []byte) error {
@@ -55,21 +46,37 @@ func checkV5ResponseBody(body []byte) error {
 		return err
 	}
 
-	switch {
-	case commonResponse.RetCode == 10006, commonResponse.RetCode == 10018:
-		rateLimitError := &RateLimitError{}
-		if err := json.Unmarshal(body, rateLimitError); err != nil {
-			return err
-		}
-		return rateLimitError
+	return checkRetCode(commonResponse.RetCode, commonResponse.RetMsg, commonResponse.RetExtInfo, body)
+}
 
-	case commonResponse.RetCode != 0:
-		return &ErrorResponse{
-			RetCode: commonResponse.RetCode,
-			RetMsg:  commonResponse.RetMsg,
+// checkRetCode : shared by V3 and V5 whose bodies carry no rate limit fields
+func checkRetCode(retCode int, retMsg string, retExtInfo interface{}, body []byte) error {
+	switch {
+	case retCode == 0:
+		return nil
+	case isRateLimitRetCode(retCode):
+		return &RateLimitError{
+			CommonResponse: &CommonResponse{
+				RetCode: retCode,
+				RetMsg:  retMsg,
+			},
+			Response: newErrorResponse(retCode, retMsg, retExtInfo, body),
 		}
 	default:
-		return nil
+		return newErrorResponse(retCode, retMsg, retExtInfo, body)
+	}
+}
+
+func isRateLimitRetCode(retCode int) bool {
+	return retCode == 10006 || retCode == 10018
+}
+
+func newErrorResponse(retCode int, retMsg string, retExtInfo interface{}, body []byte) *ErrorResponse {
+	return &ErrorResponse{
+		RetCode:    retCode,
+		RetMsg:     retMsg,
+		RetExtInfo: retExtInfo,
+		Body:       body,
 	}
 }
 
@@ -101,10 +108,15 @@ type CommonV5Response struct {
 	Time       int         `json:"time"`
 }
 
-// ErrorResponse :
+// ErrorResponse : non-zero retCode. Compare with the RetCodeError sentinels by errors.Is
 type ErrorResponse struct {
-	RetCode int    `json:"ret_code"`
-	RetMsg  string `json:"ret_msg"`
+	RetCode    int         `json:"ret_code"`
+	RetMsg     string      `json:"ret_msg"`
+	RetExtInfo interface{} `json:"-"`
+
+	StatusCode int    `json:"-"` // HTTP status of the response
+	Endpoint   string `json:"-"` // Request path
+	Body       []byte `json:"-"` // Raw response body
 }
 
 // Error :
@@ -112,18 +124,129 @@ func (r *ErrorResponse) Error() string {
 	return fmt.Sprintf("%d, %s", r.RetCode, r.RetMsg)
 }
 
+// Is :
+func (r *ErrorResponse) Is(target error) bool {
+	t, ok := target.(*RetCodeError)
+	if !ok {
+		return false
+	}
+	return t.match(r.RetCode, r.RetMsg)
+}
+
 // RateLimitError :
 type RateLimitError struct {
 	*CommonResponse `json:",inline"`
+
+	Response *ErrorResponse `json:"-"`
 }
 
 func (r *RateLimitError) Error() string {
+	if r.RateLimitResetMs == 0 {
+		return r.RetMsg
+	}
 	return fmt.Sprintf("%s, %s", r.RetMsg, time.Until(time.Unix(int64(r.RateLimitResetMs/1000), 0)))
 }
 
+// Unwrap :
+func (r *RateLimitError) Unwrap() error {
+	if r.Response == nil {
+		return nil
+	}
+	return r.Response
+}
+
+// ResetAt : when the rate limit is lifted
+func (r *RateLimitError) ResetAt() time.Time {
+	if r.CommonResponse == nil {
+		return time.Time{}
+	}
+	return time.UnixMilli(int64(r.RateLimitResetMs))
+}
+
+// fillResetFromHeader : V5 responses carry the reset timestamp in the header only
+func (r *RateLimitError) fillResetFromHeader(header http.Header) {
+	if r.CommonResponse == nil {
+		r.CommonResponse = &CommonResponse{}
+	}
+	if r.RateLimitResetMs != 0 {
+		return
+	}
+	if resetMs, err := strconv.Atoi(header.Get(headerRateLimitReset)); err == nil {
+		r.RateLimitResetMs = resetMs
+	}
+}
+
+// UnexpectedStatusError : non-2xx status other than 403 and 404, which are returned as
+// ErrAccessDenied and ErrPathNotFound so that they can still be compared with ==
+type UnexpectedStatusError struct {
+	StatusCode int
+	Endpoint   string
+	Body       []byte
+}
+
+func (e *UnexpectedStatusError) Error() string {
+	return fmt.Sprintf("unexpected error: %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Endpoint)
+}
+
 var (
 	// ErrPathNotFound : Request path not found
 	ErrPathNotFound = errors.New("path not found")
 	// ErrAccessDenied : Access denied
 	ErrAccessDenied = errors.New("access denied")
 )
+
+// RetCodeError : sentinel for a family of well-known retCodes
+type RetCodeError struct {
+	name        string
+	codes       []int
+	msgContains string // narrows down generic codes such as 10001
+}
+
+func (e *RetCodeError) Error() string {
+	return e.name
+}
+
+func (e *RetCodeError) match(retCode int, retMsg string) bool {
+	for _, code := range e.codes {
+		if code != retCode {
+			continue
+		}
+		if e.msgContains == "" || strings.Contains(strings.ToLower(retMsg), e.msgContains) {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ErrInvalidParameter :
+	ErrInvalidParameter = &RetCodeError{name: "invalid parameter", codes: []int{10001}}
+	// ErrTimestampOutOfRecvWindow : check the clock and recv window
+	ErrTimestampOutOfRecvWindow = &RetCodeError{name: "timestamp out of recv window", codes: []int{10002}}
+	// ErrInvalidAPIKey : invalid or expired api key
+	ErrInvalidAPIKey = &RetCodeError{name: "invalid api key", codes: []int{10003, 33004}}
+	// ErrInvalidSignature :
+	ErrInvalidSignature = &RetCodeError{name: "invalid signature", codes: []int{10004}}
+	// ErrPermissionDenied : api key permission denied
+	ErrPermissionDenied = &RetCodeError{name: "permission denied", codes: []int{10005}}
+	// ErrTooManyVisits : rate limit of the exchange reached, see RateLimitError
+	ErrTooManyVisits = &RetCodeError{name: "too many visits", codes: []int{10006, 10018}}
+	// ErrUnmatchedIP : the request IP is not bound to the api key
+	ErrUnmatchedIP = &RetCodeError{name: "unmatched ip", codes: []int{10010}}
+	// ErrServerError :
+	ErrServerError = &RetCodeError{name: "server error", codes: []int{10016}}
+	// ErrOrderNotFound :
+	ErrOrderNotFound = &RetCodeError{name: "order not found", codes: []int{110001, 170213, 20001, 130010}}
+	// ErrInsufficientBalance :
+	ErrInsufficientBalance = &RetCodeError{name: "insufficient balance", codes: []int{110004, 110007, 110012, 170131}}
+	// ErrReduceOnlyViolation : reduce-only rule not satisfied
+	ErrReduceOnlyViolation = &RetCodeError{name: "reduce-only rule not satisfied", codes: []int{110017}}
+	// ErrPositionModeMismatch : position idx does not match the position mode
+	ErrPositionModeMismatch = &RetCodeError{name: "position mode mismatch", codes: []int{10001}, msgContains: "position idx not match position mode"}
+	// ErrPositionModeNotModified :
+	ErrPositionModeNotModified = &RetCodeError{name: "position mode not modified", codes: []int{110025}}
+	// ErrLeverageNotModified :
+	ErrLeverageNotModified = &RetCodeError{name: "leverage not modified", codes: []int{110043}}
+	// ErrDuplicateOrderLinkID :
+	ErrDuplicateOrderLinkID = &RetCodeError{name: "duplicate order link id", codes: []int{110072}}
+)
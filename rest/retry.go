@@ -0,0 +1,224 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// RetryPolicy : retries transient and rate-limited failures with exponential backoff and full jitter.
+//
+// Only GET calls and order creations carrying an order link ID are retried, so that a retried create
+// is deduplicated by the exchange instead of double-filling. DELETE calls are not, as a cancellation which
+// timed out may have been executed.
+//
+// An order creation whose first attempt reached the exchange although it seemed to fail, e.g. on a timeout or a 502,
+// is rejected with ErrDuplicateOrderLinkID on the retry. It is returned as *RetriedOrderCreateError then, as the order exists.
+type RetryPolicy struct {
+	MaxAttempts      int           // Including the first attempt
+	BaseDelay        time.Duration // Backoff before the second attempt, doubled on each further attempt
+	MaxDelay         time.Duration // Upper bound of the backoff
+	MaxRateLimitWait time.Duration // RateLimitError is not retried when its reset is further away than this
+}
+
+// DefaultRetryPolicy :
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:      3,
+		BaseDelay:        200 * time.Millisecond,
+		MaxDelay:         3 * time.Second,
+		MaxRateLimitWait: 5 * time.Second,
+	}
+}
+
+// WithRetryPolicy : nil disables retrying, which is the default
+func (c *Client) WithRetryPolicy(p *RetryPolicy) *Client {
+	c.retryPolicy = p
+
+	return c
+}
+
+// RetriedOrderCreateError : a retried order creation was rejected with ErrDuplicateOrderLinkID, so an earlier attempt
+// created the order. Look it up by its order link ID
+type RetriedOrderCreateError struct {
+	Attempts int // Including the rejected one
+	Err      error
+}
+
+func (e *RetriedOrderCreateError) Error() string {
+	return fmt.Sprintf("order created by an earlier attempt of %d: %s", e.Attempts, e.Err)
+}
+
+// Unwrap :
+func (e *RetriedOrderCreateError) Unwrap() error {
+	return e.Err
+}
+
+// do : builds and sends the request, rebuilding it on every attempt and after waiting for the rate limiter
+// so that it is signed afresh
+func (c *Client) do(retryable bool, build func() (*http.Request, error), dst interface{}) error {
+	maxAttempts := 1
+	if c.retryPolicy != nil && retryable && c.retryPolicy.MaxAttempts > 1 {
+		maxAttempts = c.retryPolicy.MaxAttempts
+	}
+
+	resynced, retried := false, false
+	for attempt := 1; ; attempt++ {
+		req, err := build()
+		if err != nil {
+			return err
+		}
+		// req is only built first to find its group, as a long wait would outlast its timestamp
+		waited, err := c.waitRateLimiter(req)
+		if err != nil {
+			c.onError(req, err)
+			return err
+		}
+		if waited {
+			if req, err = build(); err != nil {
+				return err
+			}
+		}
+
+		err = c.send(req, dst)
+		// only 10002 proves the server rejected the request before executing it, so that even a call
+		// which is not retryable may be signed again with a fresh offset
+		if c.clockSync != nil && !resynced && rejectedForTimestamp(err) {
+			resynced = true
+			if syncErr := c.clockSync.Sync(req.Context()); syncErr == nil {
+				attempt--
+				continue
+			}
+		}
+		if retried && errors.Is(err, ErrDuplicateOrderLinkID) {
+			return &RetriedOrderCreateError{Attempts: attempt, Err: err}
+		}
+		if err == nil || attempt >= maxAttempts {
+			return err
+		}
+
+		wait, ok := c.retryPolicy.backoff(attempt, err)
+		if !ok {
+			return err
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		retried = true
+	}
+}
+
+// rejectedForTimestamp : a 10002 response, as opposed to e.g. a timeout of a request which may have been executed
+func rejectedForTimestamp(err error) bool {
+	var errorResponse *ErrorResponse
+	return errors.As(err, &errorResponse) && errorResponse.RetCode == 10002
+}
+
+// backoff : how long to wait before retrying after the given attempt failed with err
+func (p *RetryPolicy) backoff(attempt int, err error) (time.Duration, bool) {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return 0, false
+	}
+
+	var rateLimitError *RateLimitError
+	if errors.As(err, &rateLimitError) {
+		wait := time.Until(rateLimitError.ResetAt())
+		if wait <= 0 {
+			return p.exponential(attempt), true
+		}
+		if wait > p.MaxRateLimitWait {
+			return 0, false
+		}
+		return wait, true
+	}
+
+	var statusError *UnexpectedStatusError
+	if errors.As(err, &statusError) {
+		if statusError.StatusCode >= 500 || statusError.StatusCode == http.StatusTooManyRequests {
+			return p.exponential(attempt), true
+		}
+		return 0, false
+	}
+
+	var netError net.Error
+	if errors.As(err, &netError) {
+		return p.exponential(attempt), true
+	}
+
+	return 0, false
+}
+
+func (p *RetryPolicy) exponential(attempt int) time.Duration {
+	d := p.BaseDelay << (attempt - 1)
+	if d <= 0 || d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	if d <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(d) + 1))
+}
+
+// orderCreatePaths : order link ID param name of each order creation endpoint
+var orderCreatePaths = map[string]string{
+	"/v5/order/create":                   "orderLinkId",
+	"/spot/v1/order":                     "orderLinkId",
+	"/private/linear/order/create":       "order_link_id",
+	"/private/linear/stop-order/create":  "order_link_id",
+	"/v2/private/order/create":           "order_link_id",
+	"/v2/private/stop-order/create":      "order_link_id",
+	"/futures/private/order/create":      "order_link_id",
+	"/futures/private/stop-order/create": "order_link_id",
+}
+
+// isRetryableOrderCreate : keys are the non-empty params of the request
+func isRetryableOrderCreate(path string, keys map[string]bool) bool {
+	linkIDKey, ok := orderCreatePaths[path]
+	if !ok {
+		return false
+	}
+	return keys[linkIDKey]
+}
+
+func jsonKeys(body []byte) map[string]bool {
+	parsed := map[string]interface{}{}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil
+	}
+	keys := map[string]bool{}
+	for k, v := range parsed {
+		if v != nil && v != "" {
+			keys[k] = true
+		}
+	}
+	return keys
+}
+
+func valuesKeys(values url.Values) map[string]bool {
+	keys := map[string]bool{}
+	for k := range values {
+		if values.Get(k) != "" {
+			keys[k] = true
+		}
+	}
+	return keys
+}
+
+func cloneValues(values url.Values) url.Values {
+	result := url.Values{}
+	for k, v := range values {
+		result[k] = append([]string(nil), v...)
+	}
+	return result
+}
@@ -0,0 +1,256 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/sngyai/go-bybit"
+)
+
+func TestRetryPolicyExponential(t *testing.T) {
+	p := &RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	tests := []struct {
+		attempt int
+		max     time.Duration
+	}{
+		{attempt: 1, max: 100 * time.Millisecond},
+		{attempt: 2, max: 200 * time.Millisecond},
+		{attempt: 3, max: 400 * time.Millisecond},
+		{attempt: 4, max: 800 * time.Millisecond},
+		{attempt: 5, max: time.Second},
+		{attempt: 10, max: time.Second},
+		{attempt: 70, max: time.Second}, // the shift overflows
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.attempt), func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				if d := p.exponential(tt.attempt); d < 0 || d > tt.max {
+					t.Fatalf("exponential(%d) = %s, want within [0, %s]", tt.attempt, d, tt.max)
+				}
+			}
+		})
+	}
+
+	if d := (&RetryPolicy{}).exponential(1); d != 0 {
+		t.Errorf("exponential without delays = %s, want 0", d)
+	}
+}
+
+type testNetError struct{}
+
+func (testNetError) Error() string   { return "connection reset" }
+func (testNetError) Timeout() bool   { return false }
+func (testNetError) Temporary() bool { return true }
+
+func testRateLimitError(resetAt time.Time) error {
+	return &RateLimitError{CommonResponse: &CommonResponse{RetCode: 10006, RateLimitResetMs: int(resetAt.UnixMilli())}}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := &RetryPolicy{
+		MaxAttempts:      3,
+		BaseDelay:        100 * time.Millisecond,
+		MaxDelay:         time.Second,
+		MaxRateLimitWait: 5 * time.Second,
+	}
+
+	tests := []struct {
+		name    string
+		err     error
+		wantOK  bool
+		wantMin time.Duration
+		wantMax time.Duration
+	}{
+		{name: "canceled", err: context.Canceled},
+		{name: "deadline exceeded", err: fmt.Errorf("get: %w", context.DeadlineExceeded)},
+		{name: "net error", err: &url.Error{Op: "Get", URL: "/", Err: testNetError{}}, wantOK: true, wantMax: 100 * time.Millisecond},
+		{name: "net error wrapping a deadline", err: &url.Error{Op: "Get", URL: "/", Err: context.DeadlineExceeded}},
+		{name: "502", err: &UnexpectedStatusError{StatusCode: http.StatusBadGateway}, wantOK: true, wantMax: 100 * time.Millisecond},
+		{name: "500", err: &UnexpectedStatusError{StatusCode: http.StatusInternalServerError}, wantOK: true, wantMax: 100 * time.Millisecond},
+		{name: "429", err: &UnexpectedStatusError{StatusCode: http.StatusTooManyRequests}, wantOK: true, wantMax: 100 * time.Millisecond},
+		{name: "400", err: &UnexpectedStatusError{StatusCode: http.StatusBadRequest}},
+		{name: "rate limited until soon", err: testRateLimitError(time.Now().Add(2 * time.Second)), wantOK: true, wantMin: time.Second, wantMax: 2 * time.Second},
+		{name: "rate limited until past", err: testRateLimitError(time.Now().Add(-time.Second)), wantOK: true, wantMax: 100 * time.Millisecond},
+		{name: "rate limited for too long", err: testRateLimitError(time.Now().Add(time.Minute))},
+		{name: "error response", err: newErrorResponse(110007, "insufficient balance", nil, nil)},
+		{name: "duplicate order link id", err: newErrorResponse(110072, "OrderLinkedID is duplicate", nil, nil)},
+		{name: "other", err: errors.New("invalid character")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wait, ok := p.backoff(1, tt.err)
+			if ok != tt.wantOK {
+				t.Fatalf("backoff ok = %v, want %v", ok, tt.wantOK)
+			}
+			if wait < tt.wantMin || wait > tt.wantMax {
+				t.Errorf("backoff = %s, want within [%s, %s]", wait, tt.wantMin, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestIsRetryableOrderCreate(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		keys map[string]bool
+		want bool
+	}{
+		{
+			name: "V5 with order link id",
+			path: "/v5/order/create",
+			keys: jsonKeys([]byte(`{"category":"linear","orderLinkId":"abc"}`)),
+			want: true,
+		},
+		{
+			name: "V5 without order link id",
+			path: "/v5/order/create",
+			keys: jsonKeys([]byte(`{"category":"linear"}`)),
+		},
+		{
+			name: "V5 with an empty order link id",
+			path: "/v5/order/create",
+			keys: jsonKeys([]byte(`{"category":"linear","orderLinkId":""}`)),
+		},
+		{
+			name: "V5 with a null order link id",
+			path: "/v5/order/create",
+			keys: jsonKeys([]byte(`{"category":"linear","orderLinkId":null}`)),
+		},
+		{
+			name: "V5 with the legacy param name",
+			path: "/v5/order/create",
+			keys: jsonKeys([]byte(`{"order_link_id":"abc"}`)),
+		},
+		{
+			name: "linear with order link id",
+			path: "/private/linear/order/create",
+			keys: jsonKeys([]byte(`{"order_link_id":"abc"}`)),
+			want: true,
+		},
+		{
+			name: "spot form with order link id",
+			path: "/spot/v1/order",
+			keys: valuesKeys(url.Values{"orderLinkId": {"abc"}}),
+			want: true,
+		},
+		{
+			name: "spot form with an empty order link id",
+			path: "/spot/v1/order",
+			keys: valuesKeys(url.Values{"orderLinkId": {""}}),
+		},
+		{
+			name: "amend",
+			path: "/v5/order/amend",
+			keys: jsonKeys([]byte(`{"orderLinkId":"abc"}`)),
+		},
+		{
+			name: "cancel",
+			path: "/v5/order/cancel",
+			keys: jsonKeys([]byte(`{"orderLinkId":"abc"}`)),
+		},
+		{
+			name: "batch create",
+			path: "/v5/order/create-batch",
+			keys: jsonKeys([]byte(`{"request":[{"orderLinkId":"abc"}]}`)),
+		},
+		{
+			name: "invalid body",
+			path: "/v5/order/create",
+			keys: jsonKeys([]byte(`orderLinkId=abc`)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryableOrderCreate(tt.path, tt.keys); got != tt.want {
+				t.Errorf("isRetryableOrderCreate = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetriedOrderCreate(t *testing.T) {
+	tests := []struct {
+		name         string
+		orderLinkID  string
+		wantRequests int
+		wantRetried  bool
+		wantStatus   int
+	}{
+		{
+			name:         "with order link id",
+			orderLinkID:  "abc",
+			wantRequests: 2,
+			wantRetried:  true,
+		},
+		{
+			name:         "without order link id",
+			wantRequests: 1,
+			wantStatus:   http.StatusBadGateway,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if requests == 1 {
+					// the order reached the matching engine, but the gateway timed out
+					w.WriteHeader(http.StatusBadGateway)
+					return
+				}
+				_, _ = io.WriteString(w, `{"retCode":110072,"retMsg":"OrderLinkedID is duplicate","result":{},"retExtInfo":{},"time":1}`)
+			}))
+			defer server.Close()
+
+			client := NewClient().WithBaseURL(server.URL).WithAuth("key", "secret").WithRetryPolicy(&RetryPolicy{
+				MaxAttempts: 3,
+				BaseDelay:   time.Millisecond,
+				MaxDelay:    time.Millisecond,
+			})
+			param := V5CreateOrderParam{
+				Category:  bybit.CategoryV5Linear,
+				Symbol:    bybit.SymbolV5BTCUSDT,
+				Side:      bybit.SideBuy,
+				OrderType: bybit.OrderTypeMarket,
+				Qty:       bybit.MustDecimal("0.01"),
+			}
+			if tt.orderLinkID != "" {
+				param.OrderLinkID = &tt.orderLinkID
+			}
+			_, err := client.V5().Order().CreateOrder(param)
+			if requests != tt.wantRequests {
+				t.Errorf("sent %d requests, want %d", requests, tt.wantRequests)
+			}
+
+			var retriedError *RetriedOrderCreateError
+			if got := errors.As(err, &retriedError); got != tt.wantRetried {
+				t.Fatalf("got error %v, want RetriedOrderCreateError %v", err, tt.wantRetried)
+			}
+			if tt.wantRetried {
+				if !errors.Is(err, ErrDuplicateOrderLinkID) {
+					t.Errorf("got error %v, want it to wrap %v", err, ErrDuplicateOrderLinkID)
+				}
+				if retriedError.Attempts != 2 {
+					t.Errorf("got %d attempts, want 2", retriedError.Attempts)
+				}
+			}
+			var statusError *UnexpectedStatusError
+			if tt.wantStatus != 0 && (!errors.As(err, &statusError) || statusError.StatusCode != tt.wantStatus) {
+				t.Errorf("got error %v, want status %d", err, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -67,7 +67,7 @@ type SpotSymbolsResult struct {
 func (s *SpotV1Service) SpotSymbols() (*SpotSymbolsResponse, error) {
 	var res SpotSymbolsResponse
 
-	if err := s.client.getPublicly("/spot/v1/symbols", nil, &res); err != nil {
+	if err := s.client.withEndpoint("SpotV1.SpotSymbols").getPublicly("/spot/v1/symbols", nil, &res); err != nil {
 		return nil, err
 	}
 
@@ -133,7 +133,7 @@ func (s *SpotV1Service) SpotQuoteDepth(param SpotQuoteDepthParam) (*SpotQuoteDep
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/spot/quote/v1/depth", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("SpotV1.SpotQuoteDepth").getPublicly("/spot/quote/v1/depth", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -170,7 +170,7 @@ func (s *SpotV1Service) SpotQuoteDepthMerged(param SpotQuoteDepthMergedParam) (*
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/spot/quote/v1/depth/merged", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("SpotV1.SpotQuoteDepthMerged").getPublicly("/spot/quote/v1/depth/merged", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -207,7 +207,7 @@ func (s *SpotV1Service) SpotQuoteTrades(param SpotQuoteTradesParam) (*SpotQuoteT
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/spot/quote/v1/trades", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("SpotV1.SpotQuoteTrades").getPublicly("/spot/quote/v1/trades", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -284,7 +284,7 @@ func (s *SpotV1Service) SpotQuoteKline(param SpotQuoteKlineParam) (*SpotQuoteKli
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/spot/quote/v1/kline", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("SpotV1.SpotQuoteKline").getPublicly("/spot/quote/v1/kline", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -325,7 +325,7 @@ func (s *SpotV1Service) SpotQuoteTicker24hr(param SpotQuoteTicker24hrParam) (*Sp
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/spot/quote/v1/ticker/24hr", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("SpotV1.SpotQuoteTicker24hr").getPublicly("/spot/quote/v1/ticker/24hr", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -358,7 +358,7 @@ func (s *SpotV1Service) SpotQuoteTickerPrice(param SpotQuoteTickerPriceParam) (*
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/spot/quote/v1/ticker/price", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("SpotV1.SpotQuoteTickerPrice").getPublicly("/spot/quote/v1/ticker/price", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -395,7 +395,7 @@ func (s *SpotV1Service) SpotQuoteTickerBookTicker(param SpotQuoteTickerBookTicke
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/spot/quote/v1/ticker/book_ticker", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("SpotV1.SpotQuoteTickerBookTicker").getPublicly("/spot/quote/v1/ticker/book_ticker", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -446,7 +446,7 @@ func (s *SpotV1Service) SpotPostOrder(param SpotPostOrderParam) (*SpotPostOrderR
 		return nil, err
 	}
 
-	if err := s.client.postForm("/spot/v1/order", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("SpotV1.SpotPostOrder").postForm("/spot/v1/order", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -498,7 +498,7 @@ func (s *SpotV1Service) SpotGetOrder(param SpotGetOrderParam) (*SpotGetOrderResp
 		return nil, err
 	}
 
-	if err := s.client.getPrivately("/spot/v1/order", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("SpotV1.SpotGetOrder").getPrivately("/spot/v1/order", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -542,7 +542,7 @@ func (s *SpotV1Service) SpotDeleteOrder(param SpotDeleteOrderParam) (*SpotDelete
 		return nil, err
 	}
 
-	if err := s.client.deletePrivately("/spot/v1/order", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("SpotV1.SpotDeleteOrder").deletePrivately("/spot/v1/order", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -574,7 +574,7 @@ func (s *SpotV1Service) SpotDeleteOrderFast(param SpotDeleteOrderFastParam) (*Sp
 		return nil, err
 	}
 
-	if err := s.client.deletePrivately("/spot/v1/order/fast", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("SpotV1.SpotDeleteOrderFast").deletePrivately("/spot/v1/order/fast", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -605,7 +605,7 @@ func (s *SpotV1Service) SpotOrderBatchCancel(param SpotOrderBatchCancelParam) (*
 		return nil, err
 	}
 
-	if err := s.client.deletePrivately("/spot/order/batch-cancel", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("SpotV1.SpotOrderBatchCancel").deletePrivately("/spot/order/batch-cancel", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -636,7 +636,7 @@ func (s *SpotV1Service) SpotOrderBatchFastCancel(param SpotOrderBatchFastCancelP
 		return nil, err
 	}
 
-	if err := s.client.deletePrivately("/spot/order/batch-fast-cancel", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("SpotV1.SpotOrderBatchFastCancel").deletePrivately("/spot/order/batch-fast-cancel", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -663,7 +663,7 @@ func (s *SpotV1Service) SpotOrderBatchCancelByIDs(orderIDs []string) (*SpotOrder
 
 	query := url.Values{}
 	query.Add("orderIds", strings.Join(orderIDs, ","))
-	if err := s.client.deletePrivately("/spot/order/batch-cancel-by-ids", query, &res); err != nil {
+	if err := s.client.withEndpoint("SpotV1.SpotOrderBatchCancelByIDs").deletePrivately("/spot/order/batch-cancel-by-ids", query, &res); err != nil {
 		return nil, err
 	}
 
@@ -716,7 +716,7 @@ func (s *SpotV1Service) SpotOpenOrders(param SpotOpenOrdersParam) (*SpotOpenOrde
 		return nil, err
 	}
 
-	if err := s.client.getPrivately("/spot/v1/open-orders", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("SpotV1.SpotOpenOrders").getPrivately("/spot/v1/open-orders", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -753,7 +753,7 @@ func (s *SpotV1Service) SpotGetWalletBalance() (*SpotGetWalletBalanceResponse, e
 		return nil, err
 	}
 
-	if err := s.client.getPrivately("/spot/v1/account", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("SpotV1.SpotGetWalletBalance").getPrivately("/spot/v1/account", queryString, &res); err != nil {
 		return nil, err
 	}
 
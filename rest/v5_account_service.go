@@ -1,15 +1,29 @@
 package rest
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/url"
 	"strings"
 
+	"github.com/google/go-querystring/query"
 	"github.com/sngyai/go-bybit"
 )
 
 // V5AccountServiceI :
 type V5AccountServiceI interface {
 	GetWalletBalance(bybit.AccountType, []bybit.Coin) (*V5WalletBalanceResponse, error)
+	UpgradeToUnifiedAccount() (*V5UpgradeToUnifiedAccountResponse, error)
+	GetBorrowHistory(V5GetBorrowHistoryParam) (*V5GetBorrowHistoryResponse, error)
+	GetCollateralInfo(V5GetCollateralInfoParam) (*V5GetCollateralInfoResponse, error)
+	GetCoinGreeks(V5GetCoinGreeksParam) (*V5GetCoinGreeksResponse, error)
+	GetFeeRate(V5GetFeeRateParam) (*V5GetFeeRateResponse, error)
+	GetAccountInfo() (*V5GetAccountInfoResponse, error)
+	GetTransactionLog(V5GetTransactionLogParam) (*V5GetTransactionLogResponse, error)
+	SetMarginMode(V5SetMarginModeParam) (*V5SetMarginModeResponse, error)
+	SetMMP(V5SetMMPParam) (*V5SetMMPResponse, error)
+	ResetMMP(V5ResetMMPParam) (*V5ResetMMPResponse, error)
+	GetMMPState(V5GetMMPStateParam) (*V5GetMMPStateResponse, error)
 }
 
 // V5AccountService :
@@ -30,33 +44,33 @@ type V5WalletBalanceResult struct {
 
 // V5WalletBalanceCoin :
 type V5WalletBalanceCoin struct {
-	AvailableToBorrow   string     `json:"availableToBorrow"`
-	AccruedInterest     string     `json:"accruedInterest"`
-	AvailableToWithdraw string     `json:"availableToWithdraw"`
-	TotalOrderIM        string     `json:"totalOrderIM"`
-	Equity              string     `json:"equity"`
-	TotalPositionMM     string     `json:"totalPositionMM"`
-	UsdValue            string     `json:"usdValue"`
-	UnrealisedPnl       string     `json:"unrealisedPnl"`
-	BorrowAmount        string     `json:"borrowAmount"`
-	TotalPositionIM     string     `json:"totalPositionIM"`
-	WalletBalance       string     `json:"walletBalance"`
-	CumRealisedPnl      string     `json:"cumRealisedPnl"`
-	Coin                bybit.Coin `json:"coin"`
+	AvailableToBorrow   bybit.Decimal `json:"availableToBorrow"`
+	AccruedInterest     bybit.Decimal `json:"accruedInterest"`
+	AvailableToWithdraw bybit.Decimal `json:"availableToWithdraw"`
+	TotalOrderIM        bybit.Decimal `json:"totalOrderIM"`
+	Equity              bybit.Decimal `json:"equity"`
+	TotalPositionMM     bybit.Decimal `json:"totalPositionMM"`
+	UsdValue            bybit.Decimal `json:"usdValue"`
+	UnrealisedPnl       bybit.Decimal `json:"unrealisedPnl"`
+	BorrowAmount        bybit.Decimal `json:"borrowAmount"`
+	TotalPositionIM     bybit.Decimal `json:"totalPositionIM"`
+	WalletBalance       bybit.Decimal `json:"walletBalance"`
+	CumRealisedPnl      bybit.Decimal `json:"cumRealisedPnl"`
+	Coin                bybit.Coin    `json:"coin"`
 }
 
 // V5WalletBalanceList :
 type V5WalletBalanceList struct {
-	TotalEquity            string                `json:"totalEquity"`
-	AccountIMRate          string                `json:"accountIMRate"`
-	TotalMarginBalance     string                `json:"totalMarginBalance"`
-	TotalInitialMargin     string                `json:"totalInitialMargin"`
+	TotalEquity            bybit.Decimal         `json:"totalEquity"`
+	AccountIMRate          bybit.Decimal         `json:"accountIMRate"`
+	TotalMarginBalance     bybit.Decimal         `json:"totalMarginBalance"`
+	TotalInitialMargin     bybit.Decimal         `json:"totalInitialMargin"`
 	AccountType            string                `json:"accountType"`
-	TotalAvailableBalance  string                `json:"totalAvailableBalance"`
-	AccountMMRate          string                `json:"accountMMRate"`
-	TotalPerpUPL           string                `json:"totalPerpUPL"`
-	TotalWalletBalance     string                `json:"totalWalletBalance"`
-	TotalMaintenanceMargin string                `json:"totalMaintenanceMargin"`
+	TotalAvailableBalance  bybit.Decimal         `json:"totalAvailableBalance"`
+	AccountMMRate          bybit.Decimal         `json:"accountMMRate"`
+	TotalPerpUPL           bybit.Decimal         `json:"totalPerpUPL"`
+	TotalWalletBalance     bybit.Decimal         `json:"totalWalletBalance"`
+	TotalMaintenanceMargin bybit.Decimal         `json:"totalMaintenanceMargin"`
 	Coin                   []V5WalletBalanceCoin `json:"coin"`
 }
 
@@ -82,7 +96,449 @@ func (s *V5AccountService) GetWalletBalance(at bybit.AccountType, coins []bybit.
 		query.Add("coin", strings.Join(coinsStr, ","))
 	}
 
-	if err := s.client.getV5Privately("/v5/account/wallet-balance", query, &res); err != nil {
+	if err := s.client.withEndpoint("V5Account.GetWalletBalance").getV5Privately("/v5/account/wallet-balance", query, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5UpgradeToUnifiedAccountResponse :
+type V5UpgradeToUnifiedAccountResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5UpgradeToUnifiedAccountResult `json:"result"`
+}
+
+// V5UpgradeToUnifiedAccountResult :
+type V5UpgradeToUnifiedAccountResult struct {
+	UnifiedUpdateStatus string `json:"unifiedUpdateStatus"` // FAIL, PROCESS or SUCCESS
+	UnifiedUpdateMsg    struct {
+		Msg []string `json:"msg"`
+	} `json:"unifiedUpdateMsg"`
+}
+
+// UpgradeToUnifiedAccount :
+func (s *V5AccountService) UpgradeToUnifiedAccount() (*V5UpgradeToUnifiedAccountResponse, error) {
+	var res V5UpgradeToUnifiedAccountResponse
+
+	if err := s.client.withEndpoint("V5Account.UpgradeToUnifiedAccount").postV5JSON("/v5/account/upgrade-to-uta", []byte("{}"), &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5GetBorrowHistoryParam :
+type V5GetBorrowHistoryParam struct {
+	Currency  *bybit.Coin `url:"currency,omitempty"`
+	StartTime *int64      `url:"startTime,omitempty"` // The start timestamp (ms)
+	EndTime   *int64      `url:"endTime,omitempty"`   // The end timestamp (ms)
+	Limit     *int        `url:"limit,omitempty"`     // Limit for data size per page. [1, 50]. Default: 20
+	Cursor    *string     `url:"cursor,omitempty"`
+}
+
+// V5GetBorrowHistoryResponse :
+type V5GetBorrowHistoryResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetBorrowHistoryResult `json:"result"`
+}
+
+// V5GetBorrowHistoryResult :
+type V5GetBorrowHistoryResult struct {
+	NextPageCursor string                   `json:"nextPageCursor"`
+	List           []V5GetBorrowHistoryItem `json:"list"`
+}
+
+// V5GetBorrowHistoryItem :
+type V5GetBorrowHistoryItem struct {
+	Currency                  bybit.Coin    `json:"currency"`
+	CreatedTime               int64         `json:"createdTime"`
+	BorrowCost                bybit.Decimal `json:"borrowCost"`
+	HourlyBorrowRate          bybit.Decimal `json:"hourlyBorrowRate"`
+	InterestBearingBorrowSize bybit.Decimal `json:"InterestBearingBorrowSize"`
+	CostExemption             string        `json:"costExemption"`
+	BorrowAmount              bybit.Decimal `json:"borrowAmount"`
+	UnrealisedLoss            bybit.Decimal `json:"unrealisedLoss"`
+	FreeBorrowedAmount        bybit.Decimal `json:"freeBorrowedAmount"`
+}
+
+// GetBorrowHistory :
+func (s *V5AccountService) GetBorrowHistory(param V5GetBorrowHistoryParam) (*V5GetBorrowHistoryResponse, error) {
+	var res V5GetBorrowHistoryResponse
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Account.GetBorrowHistory").getV5Privately("/v5/account/borrow-history", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5GetCollateralInfoParam :
+type V5GetCollateralInfoParam struct {
+	Currency *bybit.Coin `url:"currency,omitempty"`
+}
+
+// V5GetCollateralInfoResponse :
+type V5GetCollateralInfoResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetCollateralInfoResult `json:"result"`
+}
+
+// V5GetCollateralInfoResult :
+type V5GetCollateralInfoResult struct {
+	List []V5GetCollateralInfoItem `json:"list"`
+}
+
+// V5GetCollateralInfoItem :
+type V5GetCollateralInfoItem struct {
+	Currency            bybit.Coin    `json:"currency"`
+	HourlyBorrowRate    bybit.Decimal `json:"hourlyBorrowRate"`
+	MaxBorrowingAmount  bybit.Decimal `json:"maxBorrowingAmount"`
+	FreeBorrowingAmount bybit.Decimal `json:"freeBorrowingAmount"`
+	BorrowAmount        bybit.Decimal `json:"borrowAmount"`
+	AvailableToBorrow   bybit.Decimal `json:"availableToBorrow"`
+	Borrowable          bool          `json:"borrowable"`
+	MarginCollateral    bool          `json:"marginCollateral"`
+	CollateralSwitch    bool          `json:"collateralSwitch"`
+	CollateralRatio     bybit.Decimal `json:"collateralRatio"`
+}
+
+// GetCollateralInfo :
+func (s *V5AccountService) GetCollateralInfo(param V5GetCollateralInfoParam) (*V5GetCollateralInfoResponse, error) {
+	var res V5GetCollateralInfoResponse
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Account.GetCollateralInfo").getV5Privately("/v5/account/collateral-info", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5GetCoinGreeksParam :
+type V5GetCoinGreeksParam struct {
+	BaseCoin *bybit.Coin `url:"baseCoin,omitempty"` // If not passed, all supported base coin greeks will be returned by default
+}
+
+// V5GetCoinGreeksResponse :
+type V5GetCoinGreeksResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetCoinGreeksResult `json:"result"`
+}
+
+// V5GetCoinGreeksResult :
+type V5GetCoinGreeksResult struct {
+	List []V5GetCoinGreeksItem `json:"list"`
+}
+
+// V5GetCoinGreeksItem :
+type V5GetCoinGreeksItem struct {
+	BaseCoin   bybit.Coin    `json:"baseCoin"`
+	TotalDelta bybit.Decimal `json:"totalDelta"`
+	TotalGamma bybit.Decimal `json:"totalGamma"`
+	TotalVega  bybit.Decimal `json:"totalVega"`
+	TotalTheta bybit.Decimal `json:"totalTheta"`
+}
+
+// GetCoinGreeks :
+func (s *V5AccountService) GetCoinGreeks(param V5GetCoinGreeksParam) (*V5GetCoinGreeksResponse, error) {
+	var res V5GetCoinGreeksResponse
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Account.GetCoinGreeks").getV5Privately("/v5/asset/coin-greeks", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5GetFeeRateParam :
+type V5GetFeeRateParam struct {
+	Category bybit.CategoryV5 `url:"category"`
+
+	Symbol   *bybit.SymbolV5 `url:"symbol,omitempty"`
+	BaseCoin *bybit.Coin     `url:"baseCoin,omitempty"` // option only
+}
+
+// V5GetFeeRateResponse :
+type V5GetFeeRateResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetFeeRateResult `json:"result"`
+}
+
+// V5GetFeeRateResult :
+type V5GetFeeRateResult struct {
+	List []V5GetFeeRateItem `json:"list"`
+}
+
+// V5GetFeeRateItem :
+type V5GetFeeRateItem struct {
+	Symbol       bybit.SymbolV5 `json:"symbol"`
+	BaseCoin     bybit.Coin     `json:"baseCoin"`
+	TakerFeeRate bybit.Decimal  `json:"takerFeeRate"`
+	MakerFeeRate bybit.Decimal  `json:"makerFeeRate"`
+}
+
+// GetFeeRate :
+func (s *V5AccountService) GetFeeRate(param V5GetFeeRateParam) (*V5GetFeeRateResponse, error) {
+	var res V5GetFeeRateResponse
+
+	if param.BaseCoin != nil && param.Category != bybit.CategoryV5Option {
+		return nil, fmt.Errorf("baseCoin is for option only")
+	}
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Account.GetFeeRate").getV5Privately("/v5/account/fee-rate", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5GetAccountInfoResponse :
+type V5GetAccountInfoResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetAccountInfoResult `json:"result"`
+}
+
+// V5GetAccountInfoResult :
+type V5GetAccountInfoResult struct {
+	UnifiedMarginStatus int                `json:"unifiedMarginStatus"`
+	MarginMode          bybit.MarginModeV5 `json:"marginMode"`
+	DcpStatus           string             `json:"dcpStatus"`
+	TimeWindow          int                `json:"timeWindow"`
+	SmpGroup            int                `json:"smpGroup"`
+	IsMasterTrader      bool               `json:"isMasterTrader"`
+	UpdatedTime         string             `json:"updatedTime"`
+}
+
+// GetAccountInfo :
+func (s *V5AccountService) GetAccountInfo() (*V5GetAccountInfoResponse, error) {
+	var res V5GetAccountInfoResponse
+
+	if err := s.client.withEndpoint("V5Account.GetAccountInfo").getV5Privately("/v5/account/info", url.Values{}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5GetTransactionLogParam :
+type V5GetTransactionLogParam struct {
+	AccountType *bybit.AccountType `url:"accountType,omitempty"` // UNIFIED by default
+	Category    *bybit.CategoryV5  `url:"category,omitempty"`
+	Currency    *bybit.Coin        `url:"currency,omitempty"`
+	BaseCoin    *bybit.Coin        `url:"baseCoin,omitempty"`
+	Type        *string            `url:"type,omitempty"`      // Transaction type, e.g. TRADE, SETTLEMENT, TRANSFER_IN
+	StartTime   *int64             `url:"startTime,omitempty"` // The start timestamp (ms)
+	EndTime     *int64             `url:"endTime,omitempty"`   // The end timestamp (ms)
+	Limit       *int               `url:"limit,omitempty"`     // Limit for data size per page. [1, 50]. Default: 20
+	Cursor      *string            `url:"cursor,omitempty"`
+}
+
+// V5GetTransactionLogResponse :
+type V5GetTransactionLogResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetTransactionLogResult `json:"result"`
+}
+
+// V5GetTransactionLogResult :
+type V5GetTransactionLogResult struct {
+	NextPageCursor string                    `json:"nextPageCursor"`
+	List           []V5GetTransactionLogItem `json:"list"`
+}
+
+// V5GetTransactionLogItem :
+type V5GetTransactionLogItem struct {
+	Symbol          bybit.SymbolV5   `json:"symbol"`
+	Category        bybit.CategoryV5 `json:"category"`
+	Side            bybit.Side       `json:"side"`
+	TransactionTime string           `json:"transactionTime"`
+	Type            string           `json:"type"`
+	Qty             bybit.Decimal    `json:"qty"`
+	Size            bybit.Decimal    `json:"size"`
+	Currency        bybit.Coin       `json:"currency"`
+	TradePrice      bybit.Decimal    `json:"tradePrice"`
+	Funding         bybit.Decimal    `json:"funding"`
+	Fee             bybit.Decimal    `json:"fee"`
+	CashFlow        bybit.Decimal    `json:"cashFlow"`
+	Change          bybit.Decimal    `json:"change"`
+	CashBalance     bybit.Decimal    `json:"cashBalance"`
+	FeeRate         bybit.Decimal    `json:"feeRate"`
+	BonusChange     bybit.Decimal    `json:"bonusChange"`
+	TradeID         string           `json:"tradeId"`
+	OrderID         string           `json:"orderId"`
+	OrderLinkID     string           `json:"orderLinkId"`
+}
+
+// GetTransactionLog :
+func (s *V5AccountService) GetTransactionLog(param V5GetTransactionLogParam) (*V5GetTransactionLogResponse, error) {
+	var res V5GetTransactionLogResponse
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Account.GetTransactionLog").getV5Privately("/v5/account/transaction-log", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5SetMarginModeParam :
+type V5SetMarginModeParam struct {
+	SetMarginMode bybit.MarginModeV5 `json:"setMarginMode"`
+}
+
+// V5SetMarginModeResponse :
+type V5SetMarginModeResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5SetMarginModeResult `json:"result"`
+}
+
+// V5SetMarginModeResult :
+type V5SetMarginModeResult struct {
+	Reasons []struct {
+		ReasonCode string `json:"reasonCode"`
+		ReasonMsg  string `json:"reasonMsg"`
+	} `json:"reasons"`
+}
+
+// SetMarginMode :
+func (s *V5AccountService) SetMarginMode(param V5SetMarginModeParam) (*V5SetMarginModeResponse, error) {
+	var res V5SetMarginModeResponse
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5Account.SetMarginMode").postV5JSON("/v5/account/set-margin-mode", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5SetMMPParam :
+type V5SetMMPParam struct {
+	BaseCoin     bybit.Coin    `json:"baseCoin"`
+	Window       string        `json:"window"`       // Time window (ms)
+	FrozenPeriod string        `json:"frozenPeriod"` // Frozen period (ms). "0" means the trade will remain frozen until manually reset
+	QtyLimit     bybit.Decimal `json:"qtyLimit"`     // Trade qty limit
+	DeltaLimit   bybit.Decimal `json:"deltaLimit"`   // Delta limit
+}
+
+// V5SetMMPResponse :
+type V5SetMMPResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           interface{} `json:"result"`
+}
+
+// SetMMP : option only
+func (s *V5AccountService) SetMMP(param V5SetMMPParam) (*V5SetMMPResponse, error) {
+	var res V5SetMMPResponse
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5Account.SetMMP").postV5JSON("/v5/account/mmp-modify", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5ResetMMPParam :
+type V5ResetMMPParam struct {
+	BaseCoin bybit.Coin `json:"baseCoin"`
+}
+
+// V5ResetMMPResponse :
+type V5ResetMMPResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           interface{} `json:"result"`
+}
+
+// ResetMMP : option only
+func (s *V5AccountService) ResetMMP(param V5ResetMMPParam) (*V5ResetMMPResponse, error) {
+	var res V5ResetMMPResponse
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5Account.ResetMMP").postV5JSON("/v5/account/mmp-reset", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5GetMMPStateParam :
+type V5GetMMPStateParam struct {
+	BaseCoin bybit.Coin `url:"baseCoin"`
+}
+
+// V5GetMMPStateResponse :
+type V5GetMMPStateResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetMMPStateResult `json:"result"`
+}
+
+// V5GetMMPStateResult :
+type V5GetMMPStateResult struct {
+	Result []V5GetMMPStateItem `json:"result"`
+}
+
+// V5GetMMPStateItem :
+type V5GetMMPStateItem struct {
+	BaseCoin       bybit.Coin    `json:"baseCoin"`
+	MmpEnabled     bool          `json:"mmpEnabled"`
+	Window         string        `json:"window"`
+	FrozenPeriod   string        `json:"frozenPeriod"`
+	QtyLimit       bybit.Decimal `json:"qtyLimit"`
+	DeltaLimit     bybit.Decimal `json:"deltaLimit"`
+	MmpFrozenUntil string        `json:"mmpFrozenUntil"`
+	MmpFrozen      bool          `json:"mmpFrozen"`
+}
+
+// GetMMPState : option only
+func (s *V5AccountService) GetMMPState(param V5GetMMPStateParam) (*V5GetMMPStateResponse, error) {
+	var res V5GetMMPStateResponse
+
+	if param.BaseCoin == "" {
+		return nil, fmt.Errorf("BaseCoin needed")
+	}
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Account.GetMMPState").getV5Privately("/v5/account/mmp-state", queryString, &res); err != nil {
 		return nil, err
 	}
 
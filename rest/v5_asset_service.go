@@ -1,10 +1,607 @@
 package rest
 
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/go-querystring/query"
+	"github.com/sngyai/go-bybit"
+)
+
 // V5AssetServiceI :
 type V5AssetServiceI interface {
+	CreateInternalTransfer(V5CreateInternalTransferParam) (*V5CreateInternalTransferResponse, error)
+	GetInternalTransferRecords(V5GetInternalTransferRecordsParam) (*V5GetInternalTransferRecordsResponse, error)
+	CreateUniversalTransfer(V5CreateUniversalTransferParam) (*V5CreateUniversalTransferResponse, error)
+	GetUniversalTransferRecords(V5GetUniversalTransferRecordsParam) (*V5GetUniversalTransferRecordsResponse, error)
+	GetDepositRecords(V5GetDepositRecordsParam) (*V5GetDepositRecordsResponse, error)
+	GetSubDepositRecords(V5GetSubDepositRecordsParam) (*V5GetSubDepositRecordsResponse, error)
+	GetWithdrawalRecords(V5GetWithdrawalRecordsParam) (*V5GetWithdrawalRecordsResponse, error)
+	GetCoinInfo(V5GetCoinInfoParam) (*V5GetCoinInfoResponse, error)
+	Withdraw(V5WithdrawParam) (*V5WithdrawResponse, error)
+	CancelWithdraw(V5CancelWithdrawParam) (*V5CancelWithdrawResponse, error)
+	GetDeliveryRecord(V5GetDeliveryRecordParam) (*V5GetDeliveryRecordResponse, error)
+	GetSettlementRecord(V5GetSettlementRecordParam) (*V5GetSettlementRecordResponse, error)
 }
 
 // V5AssetService :
 type V5AssetService struct {
 	client *Client
 }
+
+// V5CreateInternalTransferParam :
+type V5CreateInternalTransferParam struct {
+	TransferID      string            `json:"transferId"` // UUID. Please manually generate a UUID, it is used for idempotency
+	Coin            bybit.Coin        `json:"coin"`
+	Amount          bybit.Decimal     `json:"amount"`
+	FromAccountType bybit.AccountType `json:"fromAccountType"`
+	ToAccountType   bybit.AccountType `json:"toAccountType"`
+}
+
+// V5CreateInternalTransferResponse :
+type V5CreateInternalTransferResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5CreateInternalTransferResult `json:"result"`
+}
+
+// V5CreateInternalTransferResult :
+type V5CreateInternalTransferResult struct {
+	TransferID string `json:"transferId"`
+}
+
+// CreateInternalTransfer :
+func (s *V5AssetService) CreateInternalTransfer(param V5CreateInternalTransferParam) (*V5CreateInternalTransferResponse, error) {
+	var res V5CreateInternalTransferResponse
+
+	if param.TransferID == "" {
+		return nil, fmt.Errorf("TransferID needed")
+	}
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5Asset.CreateInternalTransfer").postV5JSON("/v5/asset/transfer/inter-transfer", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5GetInternalTransferRecordsParam :
+type V5GetInternalTransferRecordsParam struct {
+	TransferID *string                 `url:"transferId,omitempty"`
+	Coin       *bybit.Coin             `url:"coin,omitempty"`
+	Status     *bybit.TransferStatusV5 `url:"status,omitempty"`
+	StartTime  *int64                  `url:"startTime,omitempty"` // The start timestamp (ms)
+	EndTime    *int64                  `url:"endTime,omitempty"`   // The end timestamp (ms)
+	Limit      *int                    `url:"limit,omitempty"`     // Limit for data size per page. [1, 50]. Default: 20
+	Cursor     *string                 `url:"cursor,omitempty"`
+}
+
+// V5GetInternalTransferRecordsResponse :
+type V5GetInternalTransferRecordsResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetInternalTransferRecordsResult `json:"result"`
+}
+
+// V5GetInternalTransferRecordsResult :
+type V5GetInternalTransferRecordsResult struct {
+	NextPageCursor string                             `json:"nextPageCursor"`
+	List           []V5GetInternalTransferRecordsItem `json:"list"`
+}
+
+// V5GetInternalTransferRecordsItem :
+type V5GetInternalTransferRecordsItem struct {
+	TransferID      string                 `json:"transferId"`
+	Coin            bybit.Coin             `json:"coin"`
+	Amount          bybit.Decimal          `json:"amount"`
+	FromAccountType bybit.AccountType      `json:"fromAccountType"`
+	ToAccountType   bybit.AccountType      `json:"toAccountType"`
+	Timestamp       string                 `json:"timestamp"`
+	Status          bybit.TransferStatusV5 `json:"status"`
+}
+
+// GetInternalTransferRecords :
+func (s *V5AssetService) GetInternalTransferRecords(param V5GetInternalTransferRecordsParam) (*V5GetInternalTransferRecordsResponse, error) {
+	var res V5GetInternalTransferRecordsResponse
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Asset.GetInternalTransferRecords").getV5Privately("/v5/asset/transfer/query-inter-transfer-list", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5CreateUniversalTransferParam :
+type V5CreateUniversalTransferParam struct {
+	TransferID      string            `json:"transferId"` // UUID. Please manually generate a UUID, it is used for idempotency
+	Coin            bybit.Coin        `json:"coin"`
+	Amount          bybit.Decimal     `json:"amount"`
+	FromMemberID    int               `json:"fromMemberId"`
+	ToMemberID      int               `json:"toMemberId"`
+	FromAccountType bybit.AccountType `json:"fromAccountType"`
+	ToAccountType   bybit.AccountType `json:"toAccountType"`
+}
+
+// V5CreateUniversalTransferResponse :
+type V5CreateUniversalTransferResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5CreateUniversalTransferResult `json:"result"`
+}
+
+// V5CreateUniversalTransferResult :
+type V5CreateUniversalTransferResult struct {
+	TransferID string `json:"transferId"`
+}
+
+// CreateUniversalTransfer :
+func (s *V5AssetService) CreateUniversalTransfer(param V5CreateUniversalTransferParam) (*V5CreateUniversalTransferResponse, error) {
+	var res V5CreateUniversalTransferResponse
+
+	if param.TransferID == "" {
+		return nil, fmt.Errorf("TransferID needed")
+	}
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5Asset.CreateUniversalTransfer").postV5JSON("/v5/asset/transfer/universal-transfer", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5GetUniversalTransferRecordsParam :
+type V5GetUniversalTransferRecordsParam struct {
+	TransferID *string                 `url:"transferId,omitempty"`
+	Coin       *bybit.Coin             `url:"coin,omitempty"`
+	Status     *bybit.TransferStatusV5 `url:"status,omitempty"`
+	StartTime  *int64                  `url:"startTime,omitempty"` // The start timestamp (ms)
+	EndTime    *int64                  `url:"endTime,omitempty"`   // The end timestamp (ms)
+	Limit      *int                    `url:"limit,omitempty"`     // Limit for data size per page. [1, 50]. Default: 20
+	Cursor     *string                 `url:"cursor,omitempty"`
+}
+
+// V5GetUniversalTransferRecordsResponse :
+type V5GetUniversalTransferRecordsResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetUniversalTransferRecordsResult `json:"result"`
+}
+
+// V5GetUniversalTransferRecordsResult :
+type V5GetUniversalTransferRecordsResult struct {
+	NextPageCursor string                              `json:"nextPageCursor"`
+	List           []V5GetUniversalTransferRecordsItem `json:"list"`
+}
+
+// V5GetUniversalTransferRecordsItem :
+type V5GetUniversalTransferRecordsItem struct {
+	TransferID      string                 `json:"transferId"`
+	Coin            bybit.Coin             `json:"coin"`
+	Amount          bybit.Decimal          `json:"amount"`
+	FromMemberID    string                 `json:"fromMemberId"`
+	ToMemberID      string                 `json:"toMemberId"`
+	FromAccountType bybit.AccountType      `json:"fromAccountType"`
+	ToAccountType   bybit.AccountType      `json:"toAccountType"`
+	Timestamp       string                 `json:"timestamp"`
+	Status          bybit.TransferStatusV5 `json:"status"`
+}
+
+// GetUniversalTransferRecords :
+func (s *V5AssetService) GetUniversalTransferRecords(param V5GetUniversalTransferRecordsParam) (*V5GetUniversalTransferRecordsResponse, error) {
+	var res V5GetUniversalTransferRecordsResponse
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Asset.GetUniversalTransferRecords").getV5Privately("/v5/asset/transfer/query-universal-transfer-list", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5GetDepositRecordsParam :
+type V5GetDepositRecordsParam struct {
+	Coin      *bybit.Coin `url:"coin,omitempty"`
+	StartTime *int64      `url:"startTime,omitempty"` // The start timestamp (ms)
+	EndTime   *int64      `url:"endTime,omitempty"`   // The end timestamp (ms)
+	Limit     *int        `url:"limit,omitempty"`     // Limit for data size per page. [1, 50]. Default: 50
+	Cursor    *string     `url:"cursor,omitempty"`
+}
+
+// V5GetDepositRecordsResponse :
+type V5GetDepositRecordsResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetDepositRecordsResult `json:"result"`
+}
+
+// V5GetDepositRecordsResult :
+type V5GetDepositRecordsResult struct {
+	NextPageCursor string               `json:"nextPageCursor"`
+	Rows           []V5DepositRecordRow `json:"rows"`
+}
+
+// V5DepositRecordRow :
+type V5DepositRecordRow struct {
+	Coin          bybit.Coin    `json:"coin"`
+	Chain         string        `json:"chain"`
+	Amount        bybit.Decimal `json:"amount"`
+	TxID          string        `json:"txID"`
+	Status        int           `json:"status"`
+	ToAddress     string        `json:"toAddress"`
+	Tag           string        `json:"tag"`
+	DepositFee    bybit.Decimal `json:"depositFee"`
+	SuccessAt     string        `json:"successAt"`
+	Confirmations string        `json:"confirmations"`
+	TxIndex       string        `json:"txIndex"`
+	BlockHash     string        `json:"blockHash"`
+}
+
+// GetDepositRecords :
+func (s *V5AssetService) GetDepositRecords(param V5GetDepositRecordsParam) (*V5GetDepositRecordsResponse, error) {
+	var res V5GetDepositRecordsResponse
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Asset.GetDepositRecords").getV5Privately("/v5/asset/deposit/query-record", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5GetSubDepositRecordsParam :
+type V5GetSubDepositRecordsParam struct {
+	SubMemberID string `url:"subMemberId"`
+
+	Coin      *bybit.Coin `url:"coin,omitempty"`
+	StartTime *int64      `url:"startTime,omitempty"` // The start timestamp (ms)
+	EndTime   *int64      `url:"endTime,omitempty"`   // The end timestamp (ms)
+	Limit     *int        `url:"limit,omitempty"`     // Limit for data size per page. [1, 50]. Default: 50
+	Cursor    *string     `url:"cursor,omitempty"`
+}
+
+// V5GetSubDepositRecordsResponse :
+type V5GetSubDepositRecordsResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetSubDepositRecordsResult `json:"result"`
+}
+
+// V5GetSubDepositRecordsResult :
+type V5GetSubDepositRecordsResult struct {
+	NextPageCursor string               `json:"nextPageCursor"`
+	Rows           []V5DepositRecordRow `json:"rows"`
+}
+
+// GetSubDepositRecords :
+func (s *V5AssetService) GetSubDepositRecords(param V5GetSubDepositRecordsParam) (*V5GetSubDepositRecordsResponse, error) {
+	var res V5GetSubDepositRecordsResponse
+
+	if param.SubMemberID == "" {
+		return nil, fmt.Errorf("SubMemberID needed")
+	}
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Asset.GetSubDepositRecords").getV5Privately("/v5/asset/deposit/query-sub-member-record", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5GetWithdrawalRecordsParam :
+type V5GetWithdrawalRecordsParam struct {
+	WithdrawID   *string               `url:"withdrawID,omitempty"`
+	Coin         *bybit.Coin           `url:"coin,omitempty"`
+	WithdrawType *bybit.WithdrawTypeV5 `url:"withdrawType,omitempty"` // If not passed, on chain withdrawal by default
+	StartTime    *int64                `url:"startTime,omitempty"`    // The start timestamp (ms)
+	EndTime      *int64                `url:"endTime,omitempty"`      // The end timestamp (ms)
+	Limit        *int                  `url:"limit,omitempty"`        // Limit for data size per page. [1, 50]. Default: 50
+	Cursor       *string               `url:"cursor,omitempty"`
+}
+
+// V5GetWithdrawalRecordsResponse :
+type V5GetWithdrawalRecordsResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetWithdrawalRecordsResult `json:"result"`
+}
+
+// V5GetWithdrawalRecordsResult :
+type V5GetWithdrawalRecordsResult struct {
+	NextPageCursor string                  `json:"nextPageCursor"`
+	Rows           []V5WithdrawalRecordRow `json:"rows"`
+}
+
+// V5WithdrawalRecordRow :
+type V5WithdrawalRecordRow struct {
+	WithdrawID   string               `json:"withdrawId"`
+	TxID         string               `json:"txID"`
+	WithdrawType bybit.WithdrawTypeV5 `json:"withdrawType"`
+	Coin         bybit.Coin           `json:"coin"`
+	Chain        string               `json:"chain"`
+	Amount       bybit.Decimal        `json:"amount"`
+	WithdrawFee  bybit.Decimal        `json:"withdrawFee"`
+	Status       string               `json:"status"`
+	ToAddress    string               `json:"toAddress"`
+	Tag          string               `json:"tag"`
+	CreateTime   string               `json:"createTime"`
+	UpdateTime   string               `json:"updateTime"`
+}
+
+// GetWithdrawalRecords :
+func (s *V5AssetService) GetWithdrawalRecords(param V5GetWithdrawalRecordsParam) (*V5GetWithdrawalRecordsResponse, error) {
+	var res V5GetWithdrawalRecordsResponse
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Asset.GetWithdrawalRecords").getV5Privately("/v5/asset/withdraw/query-record", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5GetCoinInfoParam :
+type V5GetCoinInfoParam struct {
+	Coin *bybit.Coin `url:"coin,omitempty"`
+}
+
+// V5GetCoinInfoResponse :
+type V5GetCoinInfoResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetCoinInfoResult `json:"result"`
+}
+
+// V5GetCoinInfoResult :
+type V5GetCoinInfoResult struct {
+	Rows []V5CoinInfoRow `json:"rows"`
+}
+
+// V5CoinInfoRow :
+type V5CoinInfoRow struct {
+	Name         string            `json:"name"`
+	Coin         bybit.Coin        `json:"coin"`
+	RemainAmount bybit.Decimal     `json:"remainAmount"`
+	Chains       []V5CoinInfoChain `json:"chains"`
+}
+
+// V5CoinInfoChain :
+type V5CoinInfoChain struct {
+	Chain                 string        `json:"chain"`
+	ChainType             string        `json:"chainType"`
+	Confirmation          string        `json:"confirmation"`
+	WithdrawFee           bybit.Decimal `json:"withdrawFee"`
+	DepositMin            bybit.Decimal `json:"depositMin"`
+	WithdrawMin           bybit.Decimal `json:"withdrawMin"`
+	MinAccuracy           bybit.Decimal `json:"minAccuracy"`
+	ChainDeposit          string        `json:"chainDeposit"`
+	ChainWithdraw         string        `json:"chainWithdraw"`
+	WithdrawPercentageFee bybit.Decimal `json:"withdrawPercentageFee"`
+}
+
+// GetCoinInfo :
+func (s *V5AssetService) GetCoinInfo(param V5GetCoinInfoParam) (*V5GetCoinInfoResponse, error) {
+	var res V5GetCoinInfoResponse
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Asset.GetCoinInfo").getV5Privately("/v5/asset/coin/query-info", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5WithdrawParam :
+type V5WithdrawParam struct {
+	Coin      bybit.Coin    `json:"coin"`
+	Chain     string        `json:"chain"`
+	Address   string        `json:"address"`
+	Amount    bybit.Decimal `json:"amount"`
+	Timestamp int64         `json:"timestamp"` // Current timestamp (ms). Used for preventing from withdraw replay
+
+	Tag         *string            `json:"tag,omitempty"`
+	ForceChain  *int               `json:"forceChain,omitempty"`  // 0(default): If the address is parsed out to be internal address, then internal transfer. 1: Force on-chain
+	AccountType *bybit.AccountType `json:"accountType,omitempty"` // SPOT or FUND. If not passed, SPOT by default
+}
+
+// V5WithdrawResponse :
+type V5WithdrawResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5WithdrawResult `json:"result"`
+}
+
+// V5WithdrawResult :
+type V5WithdrawResult struct {
+	ID string `json:"id"`
+}
+
+// Withdraw :
+func (s *V5AssetService) Withdraw(param V5WithdrawParam) (*V5WithdrawResponse, error) {
+	var res V5WithdrawResponse
+
+	if param.Timestamp == 0 {
+		return nil, fmt.Errorf("Timestamp needed")
+	}
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5Asset.Withdraw").postV5JSON("/v5/asset/withdraw/create", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5CancelWithdrawParam :
+type V5CancelWithdrawParam struct {
+	ID string `json:"id"`
+}
+
+// V5CancelWithdrawResponse :
+type V5CancelWithdrawResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5CancelWithdrawResult `json:"result"`
+}
+
+// V5CancelWithdrawResult :
+type V5CancelWithdrawResult struct {
+	Status int `json:"status"` // 0: fail. 1: success
+}
+
+// CancelWithdraw :
+func (s *V5AssetService) CancelWithdraw(param V5CancelWithdrawParam) (*V5CancelWithdrawResponse, error) {
+	var res V5CancelWithdrawResponse
+
+	if param.ID == "" {
+		return nil, fmt.Errorf("ID needed")
+	}
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5Asset.CancelWithdraw").postV5JSON("/v5/asset/withdraw/cancel", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5GetDeliveryRecordParam :
+type V5GetDeliveryRecordParam struct {
+	Category bybit.CategoryV5 `url:"category"` // option only
+
+	Symbol  *bybit.SymbolV5 `url:"symbol,omitempty"`
+	ExpDate *string         `url:"expDate,omitempty"` // Expiry date. e.g., 25DEC22
+	Limit   *int            `url:"limit,omitempty"`   // Limit for data size per page. [1, 50]. Default: 20
+	Cursor  *string         `url:"cursor,omitempty"`
+}
+
+// V5GetDeliveryRecordResponse :
+type V5GetDeliveryRecordResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetDeliveryRecordResult `json:"result"`
+}
+
+// V5GetDeliveryRecordResult :
+type V5GetDeliveryRecordResult struct {
+	Category       bybit.CategoryV5          `json:"category"`
+	NextPageCursor string                    `json:"nextPageCursor"`
+	List           []V5GetDeliveryRecordItem `json:"list"`
+}
+
+// V5GetDeliveryRecordItem :
+type V5GetDeliveryRecordItem struct {
+	DeliveryTime  int64          `json:"deliveryTime"`
+	Symbol        bybit.SymbolV5 `json:"symbol"`
+	Side          bybit.Side     `json:"side"`
+	Position      bybit.Decimal  `json:"position"`
+	DeliveryPrice bybit.Decimal  `json:"deliveryPrice"`
+	Strike        bybit.Decimal  `json:"strike"`
+	Fee           bybit.Decimal  `json:"fee"`
+	DeliveryRpl   bybit.Decimal  `json:"deliveryRpl"`
+}
+
+// GetDeliveryRecord :
+func (s *V5AssetService) GetDeliveryRecord(param V5GetDeliveryRecordParam) (*V5GetDeliveryRecordResponse, error) {
+	var res V5GetDeliveryRecordResponse
+
+	if param.Category != bybit.CategoryV5Option {
+		return nil, fmt.Errorf("category should be option")
+	}
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Asset.GetDeliveryRecord").getV5Privately("/v5/asset/delivery-record", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5GetSettlementRecordParam :
+type V5GetSettlementRecordParam struct {
+	Category bybit.CategoryV5 `url:"category"` // linear only
+
+	Symbol *bybit.SymbolV5 `url:"symbol,omitempty"`
+	Limit  *int            `url:"limit,omitempty"` // Limit for data size per page. [1, 50]. Default: 20
+	Cursor *string         `url:"cursor,omitempty"`
+}
+
+// V5GetSettlementRecordResponse :
+type V5GetSettlementRecordResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetSettlementRecordResult `json:"result"`
+}
+
+// V5GetSettlementRecordResult :
+type V5GetSettlementRecordResult struct {
+	Category       bybit.CategoryV5            `json:"category"`
+	NextPageCursor string                      `json:"nextPageCursor"`
+	List           []V5GetSettlementRecordItem `json:"list"`
+}
+
+// V5GetSettlementRecordItem :
+type V5GetSettlementRecordItem struct {
+	Symbol          bybit.SymbolV5 `json:"symbol"`
+	Side            bybit.Side     `json:"side"`
+	Size            bybit.Decimal  `json:"size"`
+	SessionAvgPrice bybit.Decimal  `json:"sessionAvgPrice"`
+	MarkPrice       bybit.Decimal  `json:"markPrice"`
+	RealisedPnl     bybit.Decimal  `json:"realisedPnl"`
+	CreatedTime     string         `json:"createdTime"`
+}
+
+// GetSettlementRecord :
+func (s *V5AssetService) GetSettlementRecord(param V5GetSettlementRecordParam) (*V5GetSettlementRecordResponse, error) {
+	var res V5GetSettlementRecordResponse
+
+	if param.Category != bybit.CategoryV5Linear {
+		return nil, fmt.Errorf("category should be linear")
+	}
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Asset.GetSettlementRecord").getV5Privately("/v5/asset/settlement-record", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
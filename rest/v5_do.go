@@ -0,0 +1,59 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/go-querystring/query"
+)
+
+// V5Response : the envelope of a V5 endpoint, Result being decoded into T
+type V5Response[T any] struct {
+	CommonV5Response `json:",inline"`
+	Result           T `json:"result"`
+}
+
+// DoV5 : calls a V5 endpoint which has no method of its own yet.
+//
+//	res, err := rest.DoV5[MyParam, MyResult](client, http.MethodGet, "/v5/some/endpoint", MyParam{...})
+//
+// GET encodes req to the query by its `url` tags, POST to the body by its `json` tags.
+// The request is signed with the recv window of the client when it has an api key,
+// and a non-zero retCode is returned as *ErrorResponse as for the other V5 methods
+func DoV5[Req any, Res any](c *Client, method string, path string, req Req) (*V5Response[Res], error) {
+	var res V5Response[Res]
+
+	client := c.withCheckResponseBody(checkV5ResponseBody)
+	switch method {
+	case http.MethodGet:
+		queryString, err := query.Values(req)
+		if err != nil {
+			return nil, err
+		}
+		if queryString == nil {
+			queryString = url.Values{}
+		}
+		if client.hasAuth() {
+			err = client.getV5Privately(path, queryString, &res)
+		} else {
+			err = client.getPublicly(path, queryString, &res)
+		}
+		if err != nil {
+			return nil, err
+		}
+	case http.MethodPost:
+		body, err := json.Marshal(req)
+		if err != nil {
+			return nil, err
+		}
+		if err := client.postV5JSON(path, body, &res); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("method %s not supported, use GET or POST", method)
+	}
+
+	return &res, nil
+}
@@ -1,10 +1,95 @@
 package rest
 
+import (
+	"fmt"
+
+	"github.com/google/go-querystring/query"
+	"github.com/sngyai/go-bybit"
+)
+
 // V5ExecutionServiceI :
 type V5ExecutionServiceI interface {
+	GetExecutionList(V5GetExecutionListParam) (*V5GetExecutionListResponse, error)
 }
 
 // V5ExecutionService :
 type V5ExecutionService struct {
 	client *Client
 }
+
+// V5GetExecutionListParam :
+type V5GetExecutionListParam struct {
+	Category bybit.CategoryV5 `url:"category"`
+
+	Symbol      *bybit.SymbolV5 `url:"symbol,omitempty"`
+	OrderID     *string         `url:"orderId,omitempty"`
+	OrderLinkID *string         `url:"orderLinkId,omitempty"`
+	BaseCoin    *bybit.Coin     `url:"baseCoin,omitempty"`
+	StartTime   *int64          `url:"startTime,omitempty"` // The start timestamp (ms)
+	EndTime     *int64          `url:"endTime,omitempty"`   // The end timestamp (ms)
+	ExecType    *bybit.ExecType `url:"execType,omitempty"`
+	Limit       *int            `url:"limit,omitempty"` // Limit for data size per page. [1, 100]. Default: 50
+	Cursor      *string         `url:"cursor,omitempty"`
+}
+
+// V5GetExecutionListResponse :
+type V5GetExecutionListResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetExecutionListResult `json:"result"`
+}
+
+// V5GetExecutionListResult :
+type V5GetExecutionListResult struct {
+	Category       bybit.CategoryV5         `json:"category"`
+	NextPageCursor string                   `json:"nextPageCursor"`
+	List           []V5GetExecutionListItem `json:"list"`
+}
+
+// V5GetExecutionListItem :
+type V5GetExecutionListItem struct {
+	Symbol          bybit.SymbolV5  `json:"symbol"`
+	OrderID         string          `json:"orderId"`
+	OrderLinkID     string          `json:"orderLinkId"`
+	Side            bybit.Side      `json:"side"`
+	OrderPrice      bybit.Decimal   `json:"orderPrice"`
+	OrderQty        bybit.Decimal   `json:"orderQty"`
+	LeavesQty       bybit.Decimal   `json:"leavesQty"`
+	OrderType       bybit.OrderType `json:"orderType"`
+	StopOrderType   string          `json:"stopOrderType"`
+	ExecFee         bybit.Decimal   `json:"execFee"`
+	ExecID          string          `json:"execId"`
+	ExecPrice       bybit.Decimal   `json:"execPrice"`
+	ExecQty         bybit.Decimal   `json:"execQty"`
+	ExecType        bybit.ExecType  `json:"execType"`
+	ExecValue       bybit.Decimal   `json:"execValue"`
+	ExecTime        string          `json:"execTime"`
+	IsMaker         bool            `json:"isMaker"`
+	FeeRate         bybit.Decimal   `json:"feeRate"`
+	TradeIv         bybit.Decimal   `json:"tradeIv"` // option only
+	MarkIv          bybit.Decimal   `json:"markIv"`  // option only
+	MarkPrice       bybit.Decimal   `json:"markPrice"`
+	IndexPrice      bybit.Decimal   `json:"indexPrice"`
+	UnderlyingPrice bybit.Decimal   `json:"underlyingPrice"` // option only
+	BlockTradeID    string          `json:"blockTradeId"`
+	ClosedSize      bybit.Decimal   `json:"closedSize"`
+}
+
+// GetExecutionList :
+func (s *V5ExecutionService) GetExecutionList(param V5GetExecutionListParam) (*V5GetExecutionListResponse, error) {
+	var res V5GetExecutionListResponse
+
+	if param.Category == "" {
+		return nil, fmt.Errorf("Category needed")
+	}
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Execution.GetExecutionList").getV5Privately("/v5/execution/list", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,420 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/sngyai/go-bybit"
+)
+
+var (
+	// ErrInstrumentNotFound : symbol is not listed in the category
+	ErrInstrumentNotFound = errors.New("instrument not found")
+	// ErrOrderValidation : order rejected locally before being sent
+	ErrOrderValidation = errors.New("order validation failed")
+)
+
+// V5Instrument : trading rules of a symbol, normalized across categories.
+// Rules the category does not define are empty
+type V5Instrument struct {
+	Category bybit.CategoryV5
+	Symbol   bybit.SymbolV5
+	Status   bybit.InstrumentStatus
+
+	TickSize bybit.Decimal
+	MinPrice bybit.Decimal // static limit of the instruments info, the dynamic band around the mark price is not applied
+	MaxPrice bybit.Decimal // static limit of the instruments info, the dynamic band around the mark price is not applied
+
+	QtyStep     bybit.Decimal // basePrecision for spot
+	MinOrderQty bybit.Decimal
+	MaxOrderQty bybit.Decimal
+
+	QuotePrecision bybit.Decimal // spot only. Step of the qty of market buy orders, which is in quote coin
+	MinNotional    bybit.Decimal // minOrderAmt for spot, minNotionalValue for linear
+	MaxNotional    bybit.Decimal // maxOrderAmt for spot
+}
+
+// V5InstrumentRegistry : caches instruments info per category, to normalize and validate orders before they are sent.
+//
+//	registry := rest.NewV5InstrumentRegistry(client)
+//	if err := registry.Start(ctx, time.Hour, bybit.CategoryV5Linear); err != nil {
+//		return err
+//	}
+//	client.WithInstrumentRegistry(registry)
+type V5InstrumentRegistry struct {
+	client *Client
+
+	mu          sync.RWMutex
+	instruments map[bybit.CategoryV5]map[bybit.SymbolV5]V5Instrument
+	loadedAt    map[bybit.CategoryV5]time.Time
+	maxAge      time.Duration
+
+	loadingMu sync.Mutex
+	loading   map[bybit.CategoryV5]*instrumentsLoad
+}
+
+// instrumentsLoad : a Load shared by the concurrent lookups of a stale category
+type instrumentsLoad struct {
+	done chan struct{}
+	err  error
+}
+
+// NewV5InstrumentRegistry : client is only used for the public instruments info endpoint
+func NewV5InstrumentRegistry(client *Client) *V5InstrumentRegistry {
+	return &V5InstrumentRegistry{
+		client:      client,
+		instruments: map[bybit.CategoryV5]map[bybit.SymbolV5]V5Instrument{},
+		loadedAt:    map[bybit.CategoryV5]time.Time{},
+		maxAge:      time.Hour,
+		loading:     map[bybit.CategoryV5]*instrumentsLoad{},
+	}
+}
+
+// WithMaxAge : a category loaded longer ago than d is reloaded on the next lookup
+func (r *V5InstrumentRegistry) WithMaxAge(d time.Duration) *V5InstrumentRegistry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.maxAge = d
+
+	return r
+}
+
+// WithInstrumentRegistry : CreateOrder, BatchCreateOrder and AmendOrder of V5 normalize and validate
+// the order against r before sending it. nil disables it, which is the default
+func (c *Client) WithInstrumentRegistry(r *V5InstrumentRegistry) *Client {
+	c.instrumentRegistry = r
+
+	return c
+}
+
+// Load : fetches every instrument of the category, replacing the cached ones
+func (r *V5InstrumentRegistry) Load(ctx context.Context, category bybit.CategoryV5) error {
+	market := r.client.WithContext(ctx).V5().Market()
+	instruments := map[bybit.SymbolV5]V5Instrument{}
+
+	limit := 1000
+	var cursor *string
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		param := V5GetInstrumentsInfoParam{Category: category, Cursor: cursor}
+		if category != bybit.CategoryV5Spot {
+			param.Limit = &limit
+		}
+		res, err := market.GetInstrumentsInfo(param)
+		if err != nil {
+			return err
+		}
+
+		next := ""
+		switch {
+		case res.Result.LinearInverse != nil:
+			for _, item := range res.Result.LinearInverse.List {
+				instruments[item.Symbol] = V5Instrument{
+					Category:    category,
+					Symbol:      item.Symbol,
+					Status:      item.Status,
+					TickSize:    item.PriceFilter.TickSize,
+					MinPrice:    item.PriceFilter.MinPrice,
+					MaxPrice:    item.PriceFilter.MaxPrice,
+					QtyStep:     item.LotSizeFilter.QtyStep,
+					MinOrderQty: item.LotSizeFilter.MinOrderQty,
+					MaxOrderQty: item.LotSizeFilter.MaxOrderQty,
+					MinNotional: item.LotSizeFilter.MinNotionalValue,
+				}
+			}
+			next = res.Result.LinearInverse.NextPageCursor
+		case res.Result.Option != nil:
+			for _, item := range res.Result.Option.List {
+				instruments[item.Symbol] = V5Instrument{
+					Category:    category,
+					Symbol:      item.Symbol,
+					Status:      item.Status,
+					TickSize:    item.PriceFilter.TickSize,
+					MinPrice:    item.PriceFilter.MinPrice,
+					MaxPrice:    item.PriceFilter.MaxPrice,
+					QtyStep:     item.LotSizeFilter.QtyStep,
+					MinOrderQty: item.LotSizeFilter.MinOrderQty,
+					MaxOrderQty: item.LotSizeFilter.MaxOrderQty,
+				}
+			}
+			next = res.Result.Option.NextPageCursor
+		case res.Result.Spot != nil:
+			for _, item := range res.Result.Spot.List {
+				instruments[item.Symbol] = V5Instrument{
+					Category:       category,
+					Symbol:         item.Symbol,
+					Status:         item.Status,
+					TickSize:       item.PriceFilter.TickSize,
+					QtyStep:        item.LotSizeFilter.BasePrecision,
+					MinOrderQty:    item.LotSizeFilter.MinOrderQty,
+					MaxOrderQty:    item.LotSizeFilter.MaxOrderQty,
+					QuotePrecision: item.LotSizeFilter.QuotePrecision,
+					MinNotional:    item.LotSizeFilter.MinOrderAmt,
+					MaxNotional:    item.LotSizeFilter.MaxOrderAmt,
+				}
+			}
+		}
+
+		if next == "" || (cursor != nil && *cursor == next) {
+			break
+		}
+		cursor = &next
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.instruments[category] = instruments
+	r.loadedAt[category] = time.Now()
+	return nil
+}
+
+// Start : loads the categories once, then keeps reloading them every interval until ctx is done.
+// A failed reload keeps the previous instruments
+func (r *V5InstrumentRegistry) Start(ctx context.Context, interval time.Duration, categories ...bybit.CategoryV5) error {
+	for _, category := range categories {
+		if err := r.Load(ctx, category); err != nil {
+			return err
+		}
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for _, category := range categories {
+					_ = r.Load(ctx, category)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Get : loads the category first when it has not been loaded yet or is older than the max age.
+// Concurrent lookups of a stale category share a single load
+func (r *V5InstrumentRegistry) Get(ctx context.Context, category bybit.CategoryV5, symbol bybit.SymbolV5) (V5Instrument, error) {
+	r.mu.RLock()
+	loadedAt, loaded := r.loadedAt[category]
+	stale := !loaded || (r.maxAge > 0 && time.Since(loadedAt) > r.maxAge)
+	r.mu.RUnlock()
+
+	if stale {
+		if err := r.reload(ctx, category); err != nil && !loaded {
+			return V5Instrument{}, err
+		}
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	instrument, ok := r.instruments[category][symbol]
+	if !ok {
+		return V5Instrument{}, fmt.Errorf("%w: %s %s", ErrInstrumentNotFound, category, symbol)
+	}
+	return instrument, nil
+}
+
+// reload : Load, or waits for the load of the category already in flight, as singleflight does
+func (r *V5InstrumentRegistry) reload(ctx context.Context, category bybit.CategoryV5) error {
+	r.loadingMu.Lock()
+	if load, ok := r.loading[category]; ok {
+		r.loadingMu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-load.done:
+			return load.err
+		}
+	}
+	load := &instrumentsLoad{done: make(chan struct{})}
+	r.loading[category] = load
+	r.loadingMu.Unlock()
+
+	load.err = r.Load(ctx, category)
+
+	r.loadingMu.Lock()
+	delete(r.loading, category)
+	r.loadingMu.Unlock()
+	close(load.done)
+
+	return load.err
+}
+
+// NormalizeOrder : rounds the prices to the tick size and the qty down to the qty step,
+// then rejects the order with ErrOrderValidation when it violates the qty, notional or price limits.
+//
+// The price of buys is rounded down and the one of sells up, so that rounding never makes the order more aggressive.
+// As the side of the order does not tell which way the trigger, take profit and stop loss prices are crossed,
+// they are rounded to the nearest tick.
+// Only the static min and max price of the instruments info are checked, not the dynamic band around the mark price.
+// The notional of market orders is only checked for spot market buys, whose qty is in quote coin
+func (r *V5InstrumentRegistry) NormalizeOrder(ctx context.Context, param V5CreateOrderParam) (V5CreateOrderParam, error) {
+	instrument, err := r.Get(ctx, param.Category, param.Symbol)
+	if err != nil {
+		return param, err
+	}
+
+	qty, err := instrument.normalize(param.Side, param.OrderType, param.Qty, &param.Price, &param.TriggerPrice, &param.TakeProfit, &param.StopLoss)
+	if err != nil {
+		return param, err
+	}
+	param.Qty = qty
+	return param, nil
+}
+
+// NormalizeBatchOrder : NormalizeOrder for each item
+func (r *V5InstrumentRegistry) NormalizeBatchOrder(ctx context.Context, param V5BatchCreateOrderParam) (V5BatchCreateOrderParam, error) {
+	items := make([]V5BatchCreateOrderParamItem, len(param.Request))
+	for i, item := range param.Request {
+		instrument, err := r.Get(ctx, param.Category, item.Symbol)
+		if err != nil {
+			return param, err
+		}
+
+		qty, err := instrument.normalize(item.Side, item.OrderType, item.Qty, &item.Price, &item.TriggerPrice, &item.TakeProfit, &item.StopLoss)
+		if err != nil {
+			return param, fmt.Errorf("request[%d]: %w", i, err)
+		}
+		item.Qty = qty
+		items[i] = item
+	}
+	param.Request = items
+	return param, nil
+}
+
+// NormalizeAmendOrder : as the side is unknown, prices are rounded to the nearest tick
+func (r *V5InstrumentRegistry) NormalizeAmendOrder(ctx context.Context, param V5AmendOrderParam) (V5AmendOrderParam, error) {
+	instrument, err := r.Get(ctx, param.Category, param.Symbol)
+	if err != nil {
+		return param, err
+	}
+
+	if param.Qty != nil {
+		qty, err := instrument.normalizeQty(roundDown(*param.Qty, instrument.QtyStep), param.Price)
+		if err != nil {
+			return param, err
+		}
+		param.Qty = &qty
+	}
+	for _, price := range []**bybit.Decimal{&param.Price, &param.TriggerPrice, &param.TakeProfit, &param.StopLoss} {
+		if *price == nil || (*price).IsEmpty() {
+			continue
+		}
+		rounded := roundNearest(**price, instrument.TickSize)
+		if err := instrument.validatePrice(rounded); err != nil {
+			return param, err
+		}
+		*price = &rounded
+	}
+	return param, nil
+}
+
+// normalize : replaces the prices with rounded copies, leaving the caller's values untouched, and returns the rounded qty.
+// price is rounded by side, triggerPrices to the nearest tick
+func (i V5Instrument) normalize(side bybit.Side, orderType bybit.OrderType, qty bybit.Decimal, price **bybit.Decimal, triggerPrices ...**bybit.Decimal) (bybit.Decimal, error) {
+	if i.Status != "" && i.Status != bybit.InstrumentStatusTrading && i.Status != bybit.InstrumentStatusOnline && i.Status != bybit.InstrumentStatusAvailable {
+		return qty, fmt.Errorf("%w: %s is %s", ErrOrderValidation, i.Symbol, i.Status)
+	}
+
+	for n, p := range append([]**bybit.Decimal{price}, triggerPrices...) {
+		if *p == nil || (*p).IsEmpty() {
+			continue
+		}
+		var rounded bybit.Decimal
+		switch {
+		case n > 0:
+			rounded = roundNearest(**p, i.TickSize)
+		case side == bybit.SideSell:
+			rounded = roundUp(**p, i.TickSize)
+		default:
+			rounded = roundDown(**p, i.TickSize)
+		}
+		if err := i.validatePrice(rounded); err != nil {
+			return qty, err
+		}
+		*p = &rounded
+	}
+
+	if i.Category == bybit.CategoryV5Spot && orderType == bybit.OrderTypeMarket && side == bybit.SideBuy {
+		qty = roundDown(qty, i.QuotePrecision)
+		if err := validateRange("order value", qty, i.MinNotional, i.MaxNotional); err != nil {
+			return qty, err
+		}
+		return qty, nil
+	}
+
+	if orderType == bybit.OrderTypeMarket {
+		return i.normalizeQty(roundDown(qty, i.QtyStep), nil)
+	}
+	return i.normalizeQty(roundDown(qty, i.QtyStep), *price)
+}
+
+func (i V5Instrument) normalizeQty(qty bybit.Decimal, price *bybit.Decimal) (bybit.Decimal, error) {
+	if !qty.Decimal().IsPositive() {
+		return qty, fmt.Errorf("%w: qty %s must be positive after rounding to qty step %s", ErrOrderValidation, qty, i.QtyStep)
+	}
+	if err := validateRange("qty", qty, i.MinOrderQty, i.MaxOrderQty); err != nil {
+		return qty, err
+	}
+	if price != nil && !price.IsEmpty() {
+		notional := bybit.DecimalFrom(qty.Decimal().Mul(price.Decimal()))
+		if err := validateRange("order value", notional, i.MinNotional, i.MaxNotional); err != nil {
+			return qty, err
+		}
+	}
+	return qty, nil
+}
+
+func (i V5Instrument) validatePrice(price bybit.Decimal) error {
+	if !price.Decimal().IsPositive() {
+		return fmt.Errorf("%w: price %s must be positive", ErrOrderValidation, price)
+	}
+	return validateRange("price", price, i.MinPrice, i.MaxPrice)
+}
+
+// validateRange : empty or zero limits are not checked
+func validateRange(name string, v, min, max bybit.Decimal) error {
+	if min.Decimal().IsPositive() && v.Decimal().LessThan(min.Decimal()) {
+		return fmt.Errorf("%w: %s %s is less than %s", ErrOrderValidation, name, v, min)
+	}
+	if max.Decimal().IsPositive() && v.Decimal().GreaterThan(max.Decimal()) {
+		return fmt.Errorf("%w: %s %s is greater than %s", ErrOrderValidation, name, v, max)
+	}
+	return nil
+}
+
+func roundDown(v, step bybit.Decimal) bybit.Decimal {
+	return roundToStep(v, step, decimal.Decimal.Floor)
+}
+
+func roundUp(v, step bybit.Decimal) bybit.Decimal {
+	return roundToStep(v, step, decimal.Decimal.Ceil)
+}
+
+func roundNearest(v, step bybit.Decimal) bybit.Decimal {
+	return roundToStep(v, step, func(d decimal.Decimal) decimal.Decimal { return d.Round(0) })
+}
+
+// roundToStep : v is left as is when there is no step
+func roundToStep(v, step bybit.Decimal, round func(decimal.Decimal) decimal.Decimal) bybit.Decimal {
+	if v.IsEmpty() || !step.Decimal().IsPositive() {
+		return v
+	}
+	return bybit.DecimalFrom(round(v.Decimal().Div(step.Decimal())).Mul(step.Decimal()))
+}
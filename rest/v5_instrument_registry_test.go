@@ -0,0 +1,433 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sngyai/go-bybit"
+)
+
+var (
+	testLinearInstrument = V5Instrument{
+		Category:    bybit.CategoryV5Linear,
+		Symbol:      bybit.SymbolV5BTCUSDT,
+		Status:      bybit.InstrumentStatusTrading,
+		TickSize:    bybit.MustDecimal("0.5"),
+		MinPrice:    bybit.MustDecimal("0.5"),
+		MaxPrice:    bybit.MustDecimal("199999"),
+		QtyStep:     bybit.MustDecimal("0.001"),
+		MinOrderQty: bybit.MustDecimal("0.001"),
+		MaxOrderQty: bybit.MustDecimal("100"),
+		MinNotional: bybit.MustDecimal("5"),
+	}
+	testSpotInstrument = V5Instrument{
+		Category:       bybit.CategoryV5Spot,
+		Symbol:         bybit.SymbolV5BTCUSDT,
+		Status:         bybit.InstrumentStatusTrading,
+		TickSize:       bybit.MustDecimal("0.01"),
+		QtyStep:        bybit.MustDecimal("0.000001"),
+		MinOrderQty:    bybit.MustDecimal("0.000048"),
+		MaxOrderQty:    bybit.MustDecimal("71.73956243"),
+		QuotePrecision: bybit.MustDecimal("0.00000001"),
+		MinNotional:    bybit.MustDecimal("1"),
+		MaxNotional:    bybit.MustDecimal("2000000"),
+	}
+)
+
+func testDecimalPtr(s string) *bybit.Decimal {
+	if s == "" {
+		return nil
+	}
+	return bybit.MustDecimal(s).Ptr()
+}
+
+func testDecimalString(d *bybit.Decimal) string {
+	if d == nil {
+		return ""
+	}
+	return d.String()
+}
+
+func TestRoundToStep(t *testing.T) {
+	tests := []struct {
+		name        string
+		v           bybit.Decimal
+		step        bybit.Decimal
+		wantDown    string
+		wantUp      string
+		wantNearest string
+	}{
+		{
+			name:        "between ticks",
+			v:           bybit.MustDecimal("30000.3"),
+			step:        bybit.MustDecimal("0.5"),
+			wantDown:    "30000",
+			wantUp:      "30000.5",
+			wantNearest: "30000.5",
+		},
+		{
+			name:        "below the middle",
+			v:           bybit.MustDecimal("30000.2"),
+			step:        bybit.MustDecimal("0.5"),
+			wantDown:    "30000",
+			wantUp:      "30000.5",
+			wantNearest: "30000",
+		},
+		{
+			name:        "on a tick",
+			v:           bybit.MustDecimal("30000.5"),
+			step:        bybit.MustDecimal("0.5"),
+			wantDown:    "30000.5",
+			wantUp:      "30000.5",
+			wantNearest: "30000.5",
+		},
+		{
+			name:        "decimal step",
+			v:           bybit.MustDecimal("0.123456789"),
+			step:        bybit.MustDecimal("0.0001"),
+			wantDown:    "0.1234",
+			wantUp:      "0.1235",
+			wantNearest: "0.1235",
+		},
+		{
+			name:        "step above one",
+			v:           bybit.MustDecimal("1234"),
+			step:        bybit.MustDecimal("100"),
+			wantDown:    "1200",
+			wantUp:      "1300",
+			wantNearest: "1200",
+		},
+		{
+			name:        "no step",
+			v:           bybit.MustDecimal("30000.3"),
+			step:        bybit.MustDecimal(""),
+			wantDown:    "30000.3",
+			wantUp:      "30000.3",
+			wantNearest: "30000.3",
+		},
+		{
+			name:        "zero step",
+			v:           bybit.MustDecimal("30000.3"),
+			step:        bybit.MustDecimal("0"),
+			wantDown:    "30000.3",
+			wantUp:      "30000.3",
+			wantNearest: "30000.3",
+		},
+		{
+			name:        "empty value",
+			v:           bybit.MustDecimal(""),
+			step:        bybit.MustDecimal("0.5"),
+			wantDown:    "",
+			wantUp:      "",
+			wantNearest: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := roundDown(tt.v, tt.step).String(); got != tt.wantDown {
+				t.Errorf("roundDown = %s, want %s", got, tt.wantDown)
+			}
+			if got := roundUp(tt.v, tt.step).String(); got != tt.wantUp {
+				t.Errorf("roundUp = %s, want %s", got, tt.wantUp)
+			}
+			if got := roundNearest(tt.v, tt.step).String(); got != tt.wantNearest {
+				t.Errorf("roundNearest = %s, want %s", got, tt.wantNearest)
+			}
+		})
+	}
+}
+
+func TestV5InstrumentNormalize(t *testing.T) {
+	tests := []struct {
+		name         string
+		instrument   V5Instrument
+		side         bybit.Side
+		orderType    bybit.OrderType
+		qty          string
+		price        string
+		triggerPrice string
+		wantQty      string
+		wantPrice    string
+		wantTrigger  string
+		wantErr      error
+	}{
+		{
+			name:       "buy price rounds down",
+			instrument: testLinearInstrument,
+			side:       bybit.SideBuy,
+			orderType:  bybit.OrderTypeLimit,
+			qty:        "0.0129",
+			price:      "30000.4",
+			wantQty:    "0.012",
+			wantPrice:  "30000",
+		},
+		{
+			name:       "sell price rounds up",
+			instrument: testLinearInstrument,
+			side:       bybit.SideSell,
+			orderType:  bybit.OrderTypeLimit,
+			qty:        "0.0129",
+			price:      "30000.1",
+			wantQty:    "0.012",
+			wantPrice:  "30000.5",
+		},
+		{
+			name:         "trigger price rounds to the nearest tick",
+			instrument:   testLinearInstrument,
+			side:         bybit.SideBuy,
+			orderType:    bybit.OrderTypeMarket,
+			qty:          "0.01",
+			triggerPrice: "30000.3",
+			wantQty:      "0.01",
+			wantTrigger:  "30000.5",
+		},
+		{
+			name:         "trigger price of a sell rounds to the nearest tick",
+			instrument:   testLinearInstrument,
+			side:         bybit.SideSell,
+			orderType:    bybit.OrderTypeLimit,
+			qty:          "0.01",
+			price:        "30000.1",
+			triggerPrice: "30000.1",
+			wantQty:      "0.01",
+			wantPrice:    "30000.5",
+			wantTrigger:  "30000",
+		},
+		{
+			name:       "qty below min qty after rounding",
+			instrument: testLinearInstrument,
+			side:       bybit.SideBuy,
+			orderType:  bybit.OrderTypeMarket,
+			qty:        "0.0009",
+			wantErr:    ErrOrderValidation,
+		},
+		{
+			name:       "qty above max qty",
+			instrument: testLinearInstrument,
+			side:       bybit.SideBuy,
+			orderType:  bybit.OrderTypeMarket,
+			qty:        "100.001",
+			wantErr:    ErrOrderValidation,
+		},
+		{
+			name:       "notional below min notional",
+			instrument: testLinearInstrument,
+			side:       bybit.SideBuy,
+			orderType:  bybit.OrderTypeLimit,
+			qty:        "0.001",
+			price:      "4000",
+			wantErr:    ErrOrderValidation,
+		},
+		{
+			name:       "price above max price",
+			instrument: testLinearInstrument,
+			side:       bybit.SideSell,
+			orderType:  bybit.OrderTypeLimit,
+			qty:        "0.01",
+			price:      "199999.1",
+			wantErr:    ErrOrderValidation,
+		},
+		{
+			name: "not trading",
+			instrument: func() V5Instrument {
+				i := testLinearInstrument
+				i.Status = bybit.InstrumentStatusClosed
+				return i
+			}(),
+			side:      bybit.SideBuy,
+			orderType: bybit.OrderTypeMarket,
+			qty:       "0.01",
+			wantErr:   ErrOrderValidation,
+		},
+		{
+			name:       "spot market buy qty is in quote coin",
+			instrument: testSpotInstrument,
+			side:       bybit.SideBuy,
+			orderType:  bybit.OrderTypeMarket,
+			qty:        "100.123456789",
+			wantQty:    "100.12345678",
+		},
+		{
+			name:       "spot market buy below min notional",
+			instrument: testSpotInstrument,
+			side:       bybit.SideBuy,
+			orderType:  bybit.OrderTypeMarket,
+			qty:        "0.5",
+			wantErr:    ErrOrderValidation,
+		},
+		{
+			name:       "spot market sell qty is in base coin",
+			instrument: testSpotInstrument,
+			side:       bybit.SideSell,
+			orderType:  bybit.OrderTypeMarket,
+			qty:        "0.5",
+			wantQty:    "0.5",
+		},
+		{
+			name:       "spot market sell above max qty",
+			instrument: testSpotInstrument,
+			side:       bybit.SideSell,
+			orderType:  bybit.OrderTypeMarket,
+			qty:        "100",
+			wantErr:    ErrOrderValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			price := testDecimalPtr(tt.price)
+			triggerPrice := testDecimalPtr(tt.triggerPrice)
+			callerPrice, callerTrigger := testDecimalString(price), testDecimalString(triggerPrice)
+
+			gotPrice, gotTrigger := price, triggerPrice
+			qty, err := tt.instrument.normalize(tt.side, tt.orderType, bybit.MustDecimal(tt.qty), &gotPrice, &gotTrigger)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if testDecimalString(price) != callerPrice || testDecimalString(triggerPrice) != callerTrigger {
+				t.Errorf("changed the prices of the caller")
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got := qty.String(); got != tt.wantQty {
+				t.Errorf("qty = %s, want %s", got, tt.wantQty)
+			}
+			if got := testDecimalString(gotPrice); got != tt.wantPrice {
+				t.Errorf("price = %s, want %s", got, tt.wantPrice)
+			}
+			if got := testDecimalString(gotTrigger); got != tt.wantTrigger {
+				t.Errorf("trigger price = %s, want %s", got, tt.wantTrigger)
+			}
+		})
+	}
+}
+
+func TestV5InstrumentNormalizeQty(t *testing.T) {
+	tests := []struct {
+		name    string
+		qty     string
+		price   string
+		wantErr error
+	}{
+		{name: "within the limits", qty: "0.01", price: "30000"},
+		{name: "without price", qty: "0.001"},
+		{name: "zero", qty: "0", wantErr: ErrOrderValidation},
+		{name: "below min qty", qty: "0.0005", wantErr: ErrOrderValidation},
+		{name: "above max qty", qty: "101", wantErr: ErrOrderValidation},
+		{name: "below min notional", qty: "0.001", price: "4999.5", wantErr: ErrOrderValidation},
+		{name: "at min notional", qty: "0.001", price: "5000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := testLinearInstrument.normalizeQty(bybit.MustDecimal(tt.qty), testDecimalPtr(tt.price))
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNormalizeAmendOrder(t *testing.T) {
+	registry := NewV5InstrumentRegistry(nil).WithMaxAge(0)
+	registry.instruments[bybit.CategoryV5Linear] = map[bybit.SymbolV5]V5Instrument{bybit.SymbolV5BTCUSDT: testLinearInstrument}
+	registry.loadedAt[bybit.CategoryV5Linear] = time.Now()
+
+	tests := []struct {
+		name       string
+		symbol     bybit.SymbolV5
+		qty        string
+		price      string
+		takeProfit string
+		wantQty    string
+		wantPrice  string
+		wantProfit string
+		wantErr    error
+	}{
+		{
+			name:       "prices round to the nearest tick",
+			symbol:     bybit.SymbolV5BTCUSDT,
+			price:      "30000.3",
+			takeProfit: "31000.2",
+			wantPrice:  "30000.5",
+			wantProfit: "31000",
+		},
+		{
+			name:      "qty rounds down",
+			symbol:    bybit.SymbolV5BTCUSDT,
+			qty:       "0.0129",
+			price:     "30000",
+			wantQty:   "0.012",
+			wantPrice: "30000",
+		},
+		{
+			name:    "qty alone",
+			symbol:  bybit.SymbolV5BTCUSDT,
+			qty:     "0.0129",
+			wantQty: "0.012",
+		},
+		{
+			name:    "qty below min qty",
+			symbol:  bybit.SymbolV5BTCUSDT,
+			qty:     "0.0009",
+			wantErr: ErrOrderValidation,
+		},
+		{
+			name:    "notional below min notional",
+			symbol:  bybit.SymbolV5BTCUSDT,
+			qty:     "0.001",
+			price:   "4000",
+			wantErr: ErrOrderValidation,
+		},
+		{
+			name:    "price above max price",
+			symbol:  bybit.SymbolV5BTCUSDT,
+			price:   "200000",
+			wantErr: ErrOrderValidation,
+		},
+		{
+			name:    "unknown symbol",
+			symbol:  bybit.SymbolV5ETHUSDT,
+			price:   "2000",
+			wantErr: ErrInstrumentNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			param := V5AmendOrderParam{
+				Category:   bybit.CategoryV5Linear,
+				Symbol:     tt.symbol,
+				Qty:        testDecimalPtr(tt.qty),
+				Price:      testDecimalPtr(tt.price),
+				TakeProfit: testDecimalPtr(tt.takeProfit),
+			}
+			got, err := registry.NormalizeAmendOrder(context.Background(), param)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if s := testDecimalString(got.Qty); s != tt.wantQty {
+				t.Errorf("qty = %s, want %s", s, tt.wantQty)
+			}
+			if s := testDecimalString(got.Price); s != tt.wantPrice {
+				t.Errorf("price = %s, want %s", s, tt.wantPrice)
+			}
+			if s := testDecimalString(got.TakeProfit); s != tt.wantProfit {
+				t.Errorf("take profit = %s, want %s", s, tt.wantProfit)
+			}
+			if got.TriggerPrice != nil || got.StopLoss != nil {
+				t.Errorf("set prices which were not amended")
+			}
+			if s := testDecimalString(param.Price); s != tt.price {
+				t.Errorf("changed the price of the caller to %s", s)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/url"
 
 	"github.com/google/go-querystring/query"
 	"github.com/sngyai/go-bybit"
@@ -18,6 +19,16 @@ type V5MarketServiceI interface {
 	GetPremiumIndexPriceKline(V5GetPremiumIndexPriceKlineParam) (*V5GetPremiumIndexPriceKlineResponse, error)
 	GetInstrumentsInfo(V5GetInstrumentsInfoParam) (*V5GetInstrumentsInfoResponse, error)
 	GetTickers(V5GetTickersParam) (*V5GetTickersResponse, error)
+	GetOrderbook(V5GetOrderbookParam) (*V5GetOrderbookResponse, error)
+	GetPublicTradingHistory(V5GetPublicTradingHistoryParam) (*V5GetPublicTradingHistoryResponse, error)
+	GetFundingRateHistory(V5GetFundingRateHistoryParam) (*V5GetFundingRateHistoryResponse, error)
+	GetOpenInterest(V5GetOpenInterestParam) (*V5GetOpenInterestResponse, error)
+	GetHistoricalVolatility(V5GetHistoricalVolatilityParam) (*V5GetHistoricalVolatilityResponse, error)
+	GetInsurance(V5GetInsuranceParam) (*V5GetInsuranceResponse, error)
+	GetRiskLimit(V5GetRiskLimitParam) (*V5GetRiskLimitResponse, error)
+	GetDeliveryPrice(V5GetDeliveryPriceParam) (*V5GetDeliveryPriceResponse, error)
+	GetLongShortRatio(V5GetLongShortRatioParam) (*V5GetLongShortRatioResponse, error)
+	GetServerTime() (*V5GetServerTimeResponse, error)
 }
 
 // V5MarketService :
@@ -95,7 +106,7 @@ func (s *V5MarketService) GetKline(param V5GetKlineParam) (*V5GetKlineResponse,
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v5/market/kline", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("V5Market.GetKline").getPublicly("/v5/market/kline", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -171,7 +182,7 @@ func (s *V5MarketService) GetMarkPriceKline(param V5GetMarkPriceKlineParam) (*V5
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v5/market/mark-price-kline", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("V5Market.GetMarkPriceKline").getPublicly("/v5/market/mark-price-kline", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -247,7 +258,7 @@ func (s *V5MarketService) GetIndexPriceKline(param V5GetIndexPriceKlineParam) (*
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v5/market/index-price-kline", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("V5Market.GetIndexPriceKline").getPublicly("/v5/market/index-price-kline", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -324,7 +335,7 @@ func (s *V5MarketService) GetPremiumIndexPriceKline(param V5GetPremiumIndexPrice
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v5/market/premium-index-price-kline", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("V5Market.GetPremiumIndexPriceKline").getPublicly("/v5/market/premium-index-price-kline", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -397,23 +408,24 @@ type V5GetInstrumentsInfoLinearInverseResult struct {
 		SettleCoin      bybit.Coin             `json:"settleCoin"`
 		LaunchTime      string                 `json:"launchTime"`
 		DeliveryTime    string                 `json:"deliveryTime"`
-		DeliveryFeeRate string                 `json:"deliveryFeeRate"`
+		DeliveryFeeRate bybit.Decimal          `json:"deliveryFeeRate"`
 		PriceScale      string                 `json:"priceScale"`
 		LeverageFilter  struct {
-			MinLeverage  string `json:"minLeverage"`
-			MaxLeverage  string `json:"maxLeverage"`
-			LeverageStep string `json:"leverageStep"`
+			MinLeverage  bybit.Decimal `json:"minLeverage"`
+			MaxLeverage  bybit.Decimal `json:"maxLeverage"`
+			LeverageStep bybit.Decimal `json:"leverageStep"`
 		} `json:"leverageFilter"`
 		PriceFilter struct {
-			MinPrice string `json:"minPrice"`
-			MaxPrice string `json:"maxPrice"`
-			TickSize string `json:"tickSize"`
+			MinPrice bybit.Decimal `json:"minPrice"`
+			MaxPrice bybit.Decimal `json:"maxPrice"`
+			TickSize bybit.Decimal `json:"tickSize"`
 		} `json:"priceFilter"`
 		LotSizeFilter struct {
-			MaxOrderQty         string `json:"maxOrderQty"`
-			MinOrderQty         string `json:"minOrderQty"`
-			QtyStep             string `json:"qtyStep"`
-			PostOnlyMaxOrderQty string `json:"postOnlyMaxOrderQty"`
+			MaxOrderQty         bybit.Decimal `json:"maxOrderQty"`
+			MinOrderQty         bybit.Decimal `json:"minOrderQty"`
+			QtyStep             bybit.Decimal `json:"qtyStep"`
+			PostOnlyMaxOrderQty bybit.Decimal `json:"postOnlyMaxOrderQty"`
+			MinNotionalValue    bybit.Decimal `json:"minNotionalValue"`
 		} `json:"lotSizeFilter"`
 		UnifiedMarginTrade bool `json:"unifiedMarginTrade"`
 		FundingInterval    int  `json:"fundingInterval"`
@@ -433,16 +445,16 @@ type V5GetInstrumentsInfoOptionResult struct {
 		SettleCoin      bybit.Coin             `json:"settleCoin"`
 		LaunchTime      string                 `json:"launchTime"`
 		DeliveryTime    string                 `json:"deliveryTime"`
-		DeliveryFeeRate string                 `json:"deliveryFeeRate"`
+		DeliveryFeeRate bybit.Decimal          `json:"deliveryFeeRate"`
 		PriceFilter     struct {
-			MinPrice string `json:"minPrice"`
-			MaxPrice string `json:"maxPrice"`
-			TickSize string `json:"tickSize"`
+			MinPrice bybit.Decimal `json:"minPrice"`
+			MaxPrice bybit.Decimal `json:"maxPrice"`
+			TickSize bybit.Decimal `json:"tickSize"`
 		} `json:"priceFilter"`
 		LotSizeFilter struct {
-			MaxOrderQty string `json:"maxOrderQty"`
-			MinOrderQty string `json:"minOrderQty"`
-			QtyStep     string `json:"qtyStep"`
+			MaxOrderQty bybit.Decimal `json:"maxOrderQty"`
+			MinOrderQty bybit.Decimal `json:"minOrderQty"`
+			QtyStep     bybit.Decimal `json:"qtyStep"`
 		} `json:"lotSizeFilter"`
 	} `json:"list"`
 }
@@ -457,15 +469,15 @@ type V5GetInstrumentsInfoSpotResult struct {
 		Innovation    bybit.Innovation       `json:"innovation"`
 		Status        bybit.InstrumentStatus `json:"status"`
 		LotSizeFilter struct {
-			BasePrecision  string `json:"basePrecision"`
-			QuotePrecision string `json:"quotePrecision"`
-			MaxOrderQty    string `json:"maxOrderQty"`
-			MinOrderQty    string `json:"minOrderQty"`
-			MinOrderAmt    string `json:"minOrderAmt"`
-			MaxOrderAmt    string `json:"maxOrderAmt"`
+			BasePrecision  bybit.Decimal `json:"basePrecision"`
+			QuotePrecision bybit.Decimal `json:"quotePrecision"`
+			MaxOrderQty    bybit.Decimal `json:"maxOrderQty"`
+			MinOrderQty    bybit.Decimal `json:"minOrderQty"`
+			MinOrderAmt    bybit.Decimal `json:"minOrderAmt"`
+			MaxOrderAmt    bybit.Decimal `json:"maxOrderAmt"`
 		} `json:"lotSizeFilter"`
 		PriceFilter struct {
-			TickSize string `json:"tickSize"`
+			TickSize bybit.Decimal `json:"tickSize"`
 		} `json:"priceFilter"`
 	} `json:"list"`
 }
@@ -479,7 +491,7 @@ func (s *V5MarketService) GetInstrumentsInfo(param V5GetInstrumentsInfoParam) (*
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v5/market/instruments-info", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("V5Market.GetInstrumentsInfo").getPublicly("/v5/market/instruments-info", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -554,28 +566,28 @@ type V5GetTickersLinearInverseResult struct {
 	Category bybit.CategoryV5 `json:"category"`
 	List     []struct {
 		Symbol                 bybit.SymbolV5 `json:"symbol"`
-		LastPrice              string         `json:"lastPrice"`
-		IndexPrice             string         `json:"indexPrice"`
-		MarkPrice              string         `json:"markPrice"`
-		PrevPrice24H           string         `json:"prevPrice24h"`
-		Price24HPcnt           string         `json:"price24hPcnt"`
-		HighPrice24H           string         `json:"highPrice24h"`
-		LowPrice24H            string         `json:"lowPrice24h"`
-		PrevPrice1H            string         `json:"prevPrice1h"`
-		OpenInterest           string         `json:"openInterest"`
-		OpenInterestValue      string         `json:"openInterestValue"`
-		Turnover24H            string         `json:"turnover24h"`
-		Volume24H              string         `json:"volume24h"`
-		FundingRate            string         `json:"fundingRate"`
+		LastPrice              bybit.Decimal  `json:"lastPrice"`
+		IndexPrice             bybit.Decimal  `json:"indexPrice"`
+		MarkPrice              bybit.Decimal  `json:"markPrice"`
+		PrevPrice24H           bybit.Decimal  `json:"prevPrice24h"`
+		Price24HPcnt           bybit.Decimal  `json:"price24hPcnt"`
+		HighPrice24H           bybit.Decimal  `json:"highPrice24h"`
+		LowPrice24H            bybit.Decimal  `json:"lowPrice24h"`
+		PrevPrice1H            bybit.Decimal  `json:"prevPrice1h"`
+		OpenInterest           bybit.Decimal  `json:"openInterest"`
+		OpenInterestValue      bybit.Decimal  `json:"openInterestValue"`
+		Turnover24H            bybit.Decimal  `json:"turnover24h"`
+		Volume24H              bybit.Decimal  `json:"volume24h"`
+		FundingRate            bybit.Decimal  `json:"fundingRate"`
 		NextFundingTime        string         `json:"nextFundingTime"`
-		PredictedDeliveryPrice string         `json:"predictedDeliveryPrice"`
-		BasisRate              string         `json:"basisRate"`
-		DeliveryFeeRate        string         `json:"deliveryFeeRate"`
+		PredictedDeliveryPrice bybit.Decimal  `json:"predictedDeliveryPrice"`
+		BasisRate              bybit.Decimal  `json:"basisRate"`
+		DeliveryFeeRate        bybit.Decimal  `json:"deliveryFeeRate"`
 		DeliveryTime           string         `json:"deliveryTime"`
-		Ask1Size               string         `json:"ask1Size"`
-		Bid1Price              string         `json:"bid1Price"`
-		Ask1Price              string         `json:"ask1Price"`
-		Bid1Size               string         `json:"bid1Size"`
+		Ask1Size               bybit.Decimal  `json:"ask1Size"`
+		Bid1Price              bybit.Decimal  `json:"bid1Price"`
+		Ask1Price              bybit.Decimal  `json:"ask1Price"`
+		Bid1Size               bybit.Decimal  `json:"bid1Size"`
 	} `json:"list"`
 }
 
@@ -584,30 +596,30 @@ type V5GetTickersOptionResult struct {
 	Category bybit.CategoryV5 `json:"category"`
 	List     []struct {
 		Symbol                 bybit.SymbolV5 `json:"symbol"`
-		Bid1Price              string         `json:"bid1Price"`
-		Bid1Size               string         `json:"bid1Size"`
-		Bid1Iv                 string         `json:"bid1Iv"`
-		Ask1Price              string         `json:"ask1Price"`
-		Ask1Size               string         `json:"ask1Size"`
-		Ask1Iv                 string         `json:"ask1Iv"`
-		LastPrice              string         `json:"lastPrice"`
-		HighPrice24H           string         `json:"highPrice24h"`
-		LowPrice24H            string         `json:"lowPrice24h"`
-		MarkPrice              string         `json:"markPrice"`
-		IndexPrice             string         `json:"indexPrice"`
-		MarkIv                 string         `json:"markIv"`
-		UnderlyingPrice        string         `json:"underlyingPrice"`
-		OpenInterest           string         `json:"openInterest"`
-		Turnover24H            string         `json:"turnover24h"`
-		Volume24H              string         `json:"volume24h"`
-		TotalVolume            string         `json:"totalVolume"`
-		TotalTurnover          string         `json:"totalTurnover"`
-		Delta                  string         `json:"delta"`
-		Gamma                  string         `json:"gamma"`
-		Vega                   string         `json:"vega"`
-		Theta                  string         `json:"theta"`
-		PredictedDeliveryPrice string         `json:"predictedDeliveryPrice"`
-		Change24H              string         `json:"change24h"`
+		Bid1Price              bybit.Decimal  `json:"bid1Price"`
+		Bid1Size               bybit.Decimal  `json:"bid1Size"`
+		Bid1Iv                 bybit.Decimal  `json:"bid1Iv"`
+		Ask1Price              bybit.Decimal  `json:"ask1Price"`
+		Ask1Size               bybit.Decimal  `json:"ask1Size"`
+		Ask1Iv                 bybit.Decimal  `json:"ask1Iv"`
+		LastPrice              bybit.Decimal  `json:"lastPrice"`
+		HighPrice24H           bybit.Decimal  `json:"highPrice24h"`
+		LowPrice24H            bybit.Decimal  `json:"lowPrice24h"`
+		MarkPrice              bybit.Decimal  `json:"markPrice"`
+		IndexPrice             bybit.Decimal  `json:"indexPrice"`
+		MarkIv                 bybit.Decimal  `json:"markIv"`
+		UnderlyingPrice        bybit.Decimal  `json:"underlyingPrice"`
+		OpenInterest           bybit.Decimal  `json:"openInterest"`
+		Turnover24H            bybit.Decimal  `json:"turnover24h"`
+		Volume24H              bybit.Decimal  `json:"volume24h"`
+		TotalVolume            bybit.Decimal  `json:"totalVolume"`
+		TotalTurnover          bybit.Decimal  `json:"totalTurnover"`
+		Delta                  bybit.Decimal  `json:"delta"`
+		Gamma                  bybit.Decimal  `json:"gamma"`
+		Vega                   bybit.Decimal  `json:"vega"`
+		Theta                  bybit.Decimal  `json:"theta"`
+		PredictedDeliveryPrice bybit.Decimal  `json:"predictedDeliveryPrice"`
+		Change24H              bybit.Decimal  `json:"change24h"`
 	} `json:"list"`
 }
 
@@ -616,18 +628,18 @@ type V5GetTickersSpotResult struct {
 	Category bybit.CategoryV5 `json:"category"`
 	List     []struct {
 		Symbol        bybit.SymbolV5 `json:"symbol"`
-		Bid1Price     string         `json:"bid1Price"`
-		Bid1Size      string         `json:"bid1Size"`
-		Ask1Price     string         `json:"ask1Price"`
-		Ask1Size      string         `json:"ask1Size"`
-		LastPrice     string         `json:"lastPrice"`
-		PrevPrice24H  string         `json:"prevPrice24h"`
-		Price24HPcnt  string         `json:"price24hPcnt"`
-		HighPrice24H  string         `json:"highPrice24h"`
-		LowPrice24H   string         `json:"lowPrice24h"`
-		Turnover24H   string         `json:"turnover24h"`
-		Volume24H     string         `json:"volume24h"`
-		UsdIndexPrice string         `json:"usdIndexPrice"`
+		Bid1Price     bybit.Decimal  `json:"bid1Price"`
+		Bid1Size      bybit.Decimal  `json:"bid1Size"`
+		Ask1Price     bybit.Decimal  `json:"ask1Price"`
+		Ask1Size      bybit.Decimal  `json:"ask1Size"`
+		LastPrice     bybit.Decimal  `json:"lastPrice"`
+		PrevPrice24H  bybit.Decimal  `json:"prevPrice24h"`
+		Price24HPcnt  bybit.Decimal  `json:"price24hPcnt"`
+		HighPrice24H  bybit.Decimal  `json:"highPrice24h"`
+		LowPrice24H   bybit.Decimal  `json:"lowPrice24h"`
+		Turnover24H   bybit.Decimal  `json:"turnover24h"`
+		Volume24H     bybit.Decimal  `json:"volume24h"`
+		UsdIndexPrice bybit.Decimal  `json:"usdIndexPrice"`
 	} `json:"list"`
 }
 
@@ -644,7 +656,484 @@ func (s *V5MarketService) GetTickers(param V5GetTickersParam) (*V5GetTickersResp
 		return nil, err
 	}
 
-	if err := s.client.getPublicly("/v5/market/tickers", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("V5Market.GetTickers").getPublicly("/v5/market/tickers", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5GetOrderbookParam :
+type V5GetOrderbookParam struct {
+	Category bybit.CategoryV5 `url:"category"`
+	Symbol   bybit.SymbolV5   `url:"symbol"`
+
+	Limit *int `url:"limit,omitempty"` // Limit size for each bid and ask. spot: [1, 50], linear & inverse: [1, 200], option: [1, 25]
+}
+
+// V5GetOrderbookResponse :
+type V5GetOrderbookResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetOrderbookResult `json:"result"`
+}
+
+// V5GetOrderbookResult :
+type V5GetOrderbookResult struct {
+	Symbol    bybit.SymbolV5       `json:"s"`
+	Bids      V5GetOrderbookLevels `json:"b"`
+	Asks      V5GetOrderbookLevels `json:"a"`
+	Timestamp int64                `json:"ts"`
+	UpdateID  int64                `json:"u"`
+}
+
+// V5GetOrderbookLevels :
+type V5GetOrderbookLevels []V5GetOrderbookLevel
+
+// V5GetOrderbookLevel :
+type V5GetOrderbookLevel struct {
+	Price bybit.Decimal
+	Size  bybit.Decimal
+}
+
+// UnmarshalJSON :
+func (l *V5GetOrderbookLevels) UnmarshalJSON(data []byte) error {
+	parsedData := [][]bybit.Decimal{}
+	if err := json.Unmarshal(data, &parsedData); err != nil {
+		return err
+	}
+	items := make(V5GetOrderbookLevels, len(parsedData))
+	for i, d := range parsedData {
+		if len(d) != 2 {
+			return errors.New("so far len(items) must be 2, please check it on documents")
+		}
+		items[i] = V5GetOrderbookLevel{
+			Price: d[0],
+			Size:  d[1],
+		}
+	}
+	*l = items
+	return nil
+}
+
+// GetOrderbook :
+func (s *V5MarketService) GetOrderbook(param V5GetOrderbookParam) (*V5GetOrderbookResponse, error) {
+	var res V5GetOrderbookResponse
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Market.GetOrderbook").getPublicly("/v5/market/orderbook", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5GetPublicTradingHistoryParam :
+type V5GetPublicTradingHistoryParam struct {
+	Category bybit.CategoryV5 `url:"category"`
+
+	Symbol     *bybit.SymbolV5    `url:"symbol,omitempty"`     // required for spot, linear and inverse
+	BaseCoin   *bybit.Coin        `url:"baseCoin,omitempty"`   // option only
+	OptionType *bybit.OptionsType `url:"optionType,omitempty"` // option only
+	Limit      *int               `url:"limit,omitempty"`      // spot: [1,60], default: 60. others: [1,1000], default: 500
+}
+
+// V5GetPublicTradingHistoryResponse :
+type V5GetPublicTradingHistoryResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetPublicTradingHistoryResult `json:"result"`
+}
+
+// V5GetPublicTradingHistoryResult :
+type V5GetPublicTradingHistoryResult struct {
+	Category bybit.CategoryV5                `json:"category"`
+	List     []V5GetPublicTradingHistoryItem `json:"list"`
+}
+
+// V5GetPublicTradingHistoryItem :
+type V5GetPublicTradingHistoryItem struct {
+	ExecID       string         `json:"execId"`
+	Symbol       bybit.SymbolV5 `json:"symbol"`
+	Price        bybit.Decimal  `json:"price"`
+	Size         bybit.Decimal  `json:"size"`
+	Side         bybit.Side     `json:"side"`
+	Time         string         `json:"time"`
+	IsBlockTrade bool           `json:"isBlockTrade"`
+}
+
+// GetPublicTradingHistory :
+func (s *V5MarketService) GetPublicTradingHistory(param V5GetPublicTradingHistoryParam) (*V5GetPublicTradingHistoryResponse, error) {
+	var res V5GetPublicTradingHistoryResponse
+
+	if param.Category != bybit.CategoryV5Option && param.Symbol == nil {
+		return nil, fmt.Errorf("symbol needed for %s", param.Category)
+	}
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Market.GetPublicTradingHistory").getPublicly("/v5/market/recent-trade", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5GetFundingRateHistoryParam :
+type V5GetFundingRateHistoryParam struct {
+	Category bybit.CategoryV5 `url:"category"`
+	Symbol   bybit.SymbolV5   `url:"symbol"`
+
+	StartTime *int64 `url:"startTime,omitempty"` // The start timestamp (ms)
+	EndTime   *int64 `url:"endTime,omitempty"`   // The end timestamp (ms)
+	Limit     *int   `url:"limit,omitempty"`     // Limit for data size per page. [1, 200]. Default: 200
+}
+
+// V5GetFundingRateHistoryResponse :
+type V5GetFundingRateHistoryResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetFundingRateHistoryResult `json:"result"`
+}
+
+// V5GetFundingRateHistoryResult :
+type V5GetFundingRateHistoryResult struct {
+	Category bybit.CategoryV5              `json:"category"`
+	List     []V5GetFundingRateHistoryItem `json:"list"`
+}
+
+// V5GetFundingRateHistoryItem :
+type V5GetFundingRateHistoryItem struct {
+	Symbol               bybit.SymbolV5 `json:"symbol"`
+	FundingRate          bybit.Decimal  `json:"fundingRate"`
+	FundingRateTimestamp string         `json:"fundingRateTimestamp"`
+}
+
+// GetFundingRateHistory :
+func (s *V5MarketService) GetFundingRateHistory(param V5GetFundingRateHistoryParam) (*V5GetFundingRateHistoryResponse, error) {
+	var res V5GetFundingRateHistoryResponse
+
+	if param.Category != bybit.CategoryV5Linear && param.Category != bybit.CategoryV5Inverse {
+		return nil, fmt.Errorf("category should be linear or inverse")
+	}
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Market.GetFundingRateHistory").getPublicly("/v5/market/funding/history", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5GetOpenInterestParam :
+type V5GetOpenInterestParam struct {
+	Category     bybit.CategoryV5 `url:"category"`
+	Symbol       bybit.SymbolV5   `url:"symbol"`
+	IntervalTime bybit.Period     `url:"intervalTime"`
+
+	StartTime *int64  `url:"startTime,omitempty"` // The start timestamp (ms)
+	EndTime   *int64  `url:"endTime,omitempty"`   // The end timestamp (ms)
+	Limit     *int    `url:"limit,omitempty"`     // Limit for data size per page. [1, 200]. Default: 50
+	Cursor    *string `url:"cursor,omitempty"`
+}
+
+// V5GetOpenInterestResponse :
+type V5GetOpenInterestResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetOpenInterestResult `json:"result"`
+}
+
+// V5GetOpenInterestResult :
+type V5GetOpenInterestResult struct {
+	Category       bybit.CategoryV5        `json:"category"`
+	Symbol         bybit.SymbolV5          `json:"symbol"`
+	NextPageCursor string                  `json:"nextPageCursor"`
+	List           []V5GetOpenInterestItem `json:"list"`
+}
+
+// V5GetOpenInterestItem :
+type V5GetOpenInterestItem struct {
+	OpenInterest bybit.Decimal `json:"openInterest"`
+	Timestamp    string        `json:"timestamp"`
+}
+
+// GetOpenInterest :
+func (s *V5MarketService) GetOpenInterest(param V5GetOpenInterestParam) (*V5GetOpenInterestResponse, error) {
+	var res V5GetOpenInterestResponse
+
+	if param.Category != bybit.CategoryV5Linear && param.Category != bybit.CategoryV5Inverse {
+		return nil, fmt.Errorf("category should be linear or inverse")
+	}
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Market.GetOpenInterest").getPublicly("/v5/market/open-interest", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5GetHistoricalVolatilityParam :
+type V5GetHistoricalVolatilityParam struct {
+	Category bybit.CategoryV5 `url:"category"` // option only
+
+	BaseCoin  *bybit.Coin `url:"baseCoin,omitempty"`  // If not passed, BTC returned by default
+	Period    *int        `url:"period,omitempty"`    // Period. If not passed, it returns 7 days by default
+	StartTime *int64      `url:"startTime,omitempty"` // The start timestamp (ms)
+	EndTime   *int64      `url:"endTime,omitempty"`   // The end timestamp (ms)
+}
+
+// V5GetHistoricalVolatilityResponse :
+type V5GetHistoricalVolatilityResponse struct {
+	CommonV5Response `json:",inline"`
+	Category         bybit.CategoryV5                `json:"category"`
+	Result           []V5GetHistoricalVolatilityItem `json:"result"`
+}
+
+// V5GetHistoricalVolatilityItem :
+type V5GetHistoricalVolatilityItem struct {
+	Period int           `json:"period"`
+	Value  bybit.Decimal `json:"value"`
+	Time   string        `json:"time"`
+}
+
+// GetHistoricalVolatility :
+func (s *V5MarketService) GetHistoricalVolatility(param V5GetHistoricalVolatilityParam) (*V5GetHistoricalVolatilityResponse, error) {
+	var res V5GetHistoricalVolatilityResponse
+
+	if param.Category != bybit.CategoryV5Option {
+		return nil, fmt.Errorf("category should be option")
+	}
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Market.GetHistoricalVolatility").getPublicly("/v5/market/historical-volatility", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5GetInsuranceParam :
+type V5GetInsuranceParam struct {
+	Coin *bybit.Coin `url:"coin,omitempty"` // If not passed, it returns all coins
+}
+
+// V5GetInsuranceResponse :
+type V5GetInsuranceResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetInsuranceResult `json:"result"`
+}
+
+// V5GetInsuranceResult :
+type V5GetInsuranceResult struct {
+	UpdatedTime string               `json:"updatedTime"`
+	List        []V5GetInsuranceItem `json:"list"`
+}
+
+// V5GetInsuranceItem :
+type V5GetInsuranceItem struct {
+	Coin    bybit.Coin    `json:"coin"`
+	Balance bybit.Decimal `json:"balance"`
+	Value   bybit.Decimal `json:"value"`
+}
+
+// GetInsurance :
+func (s *V5MarketService) GetInsurance(param V5GetInsuranceParam) (*V5GetInsuranceResponse, error) {
+	var res V5GetInsuranceResponse
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Market.GetInsurance").getPublicly("/v5/market/insurance", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5GetRiskLimitParam :
+type V5GetRiskLimitParam struct {
+	Category bybit.CategoryV5 `url:"category"`
+
+	Symbol *bybit.SymbolV5 `url:"symbol,omitempty"`
+}
+
+// V5GetRiskLimitResponse :
+type V5GetRiskLimitResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetRiskLimitResult `json:"result"`
+}
+
+// V5GetRiskLimitResult :
+type V5GetRiskLimitResult struct {
+	Category bybit.CategoryV5     `json:"category"`
+	List     []V5GetRiskLimitItem `json:"list"`
+}
+
+// V5GetRiskLimitItem :
+type V5GetRiskLimitItem struct {
+	ID                int            `json:"id"`
+	Symbol            bybit.SymbolV5 `json:"symbol"`
+	RiskLimitValue    bybit.Decimal  `json:"riskLimitValue"`
+	MaintenanceMargin bybit.Decimal  `json:"maintenanceMargin"`
+	InitialMargin     bybit.Decimal  `json:"initialMargin"`
+	IsLowestRisk      int            `json:"isLowestRisk"`
+	MaxLeverage       bybit.Decimal  `json:"maxLeverage"`
+}
+
+// GetRiskLimit :
+func (s *V5MarketService) GetRiskLimit(param V5GetRiskLimitParam) (*V5GetRiskLimitResponse, error) {
+	var res V5GetRiskLimitResponse
+
+	if param.Category != bybit.CategoryV5Linear && param.Category != bybit.CategoryV5Inverse {
+		return nil, fmt.Errorf("category should be linear or inverse")
+	}
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Market.GetRiskLimit").getPublicly("/v5/market/risk-limit", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5GetDeliveryPriceParam :
+type V5GetDeliveryPriceParam struct {
+	Category bybit.CategoryV5 `url:"category"` // linear, inverse or option
+
+	Symbol   *bybit.SymbolV5 `url:"symbol,omitempty"`
+	BaseCoin *bybit.Coin     `url:"baseCoin,omitempty"` // option only. Default: BTC
+	Limit    *int            `url:"limit,omitempty"`    // Limit for data size per page. [1, 200]. Default: 50
+	Cursor   *string         `url:"cursor,omitempty"`
+}
+
+// V5GetDeliveryPriceResponse :
+type V5GetDeliveryPriceResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetDeliveryPriceResult `json:"result"`
+}
+
+// V5GetDeliveryPriceResult :
+type V5GetDeliveryPriceResult struct {
+	Category       bybit.CategoryV5         `json:"category"`
+	NextPageCursor string                   `json:"nextPageCursor"`
+	List           []V5GetDeliveryPriceItem `json:"list"`
+}
+
+// V5GetDeliveryPriceItem :
+type V5GetDeliveryPriceItem struct {
+	Symbol        bybit.SymbolV5 `json:"symbol"`
+	DeliveryPrice bybit.Decimal  `json:"deliveryPrice"`
+	DeliveryTime  string         `json:"deliveryTime"`
+}
+
+// GetDeliveryPrice :
+func (s *V5MarketService) GetDeliveryPrice(param V5GetDeliveryPriceParam) (*V5GetDeliveryPriceResponse, error) {
+	var res V5GetDeliveryPriceResponse
+
+	if param.Category == bybit.CategoryV5Spot {
+		return nil, fmt.Errorf("category should be linear, inverse or option")
+	}
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Market.GetDeliveryPrice").getPublicly("/v5/market/delivery-price", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5GetLongShortRatioParam :
+type V5GetLongShortRatioParam struct {
+	Category bybit.CategoryV5 `url:"category"`
+	Symbol   bybit.SymbolV5   `url:"symbol"`
+	Period   bybit.Period     `url:"period"`
+
+	Limit *int `url:"limit,omitempty"` // Limit for data size per page. [1, 500]. Default: 50
+}
+
+// V5GetLongShortRatioResponse :
+type V5GetLongShortRatioResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetLongShortRatioResult `json:"result"`
+}
+
+// V5GetLongShortRatioResult :
+type V5GetLongShortRatioResult struct {
+	List []V5GetLongShortRatioItem `json:"list"`
+}
+
+// V5GetLongShortRatioItem :
+type V5GetLongShortRatioItem struct {
+	Symbol    bybit.SymbolV5 `json:"symbol"`
+	BuyRatio  bybit.Decimal  `json:"buyRatio"`
+	SellRatio bybit.Decimal  `json:"sellRatio"`
+	Timestamp string         `json:"timestamp"`
+}
+
+// GetLongShortRatio :
+func (s *V5MarketService) GetLongShortRatio(param V5GetLongShortRatioParam) (*V5GetLongShortRatioResponse, error) {
+	var res V5GetLongShortRatioResponse
+
+	if param.Category != bybit.CategoryV5Linear && param.Category != bybit.CategoryV5Inverse {
+		return nil, fmt.Errorf("category should be linear or inverse")
+	}
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Market.GetLongShortRatio").getPublicly("/v5/market/account-ratio", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5GetServerTimeResponse :
+type V5GetServerTimeResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetServerTimeResult `json:"result"`
+}
+
+// V5GetServerTimeResult :
+type V5GetServerTimeResult struct {
+	TimeSecond string `json:"timeSecond"`
+	TimeNano   string `json:"timeNano"`
+}
+
+// GetServerTime :
+func (s *V5MarketService) GetServerTime() (*V5GetServerTimeResponse, error) {
+	var res V5GetServerTimeResponse
+
+	if err := s.client.withEndpoint("V5Market.GetServerTime").getPublicly("/v5/market/time", url.Values{}, &res); err != nil {
 		return nil, err
 	}
 
@@ -13,6 +13,14 @@ type V5OrderServiceI interface {
 	CreateOrder(V5CreateOrderParam) (*V5CreateOrderResponse, error)
 	CancelOrder(V5CancelOrderParam) (*V5CancelOrderResponse, error)
 	GetOpenOrders(V5GetOpenOrdersParam) (*V5GetOpenOrdersResponse, error)
+	AmendOrder(V5AmendOrderParam) (*V5AmendOrderResponse, error)
+	CancelAllOrders(V5CancelAllOrdersParam) (*V5CancelAllOrdersResponse, error)
+	GetOrderHistory(V5GetOrderHistoryParam) (*V5GetOrderHistoryResponse, error)
+	BatchCreateOrder(V5BatchCreateOrderParam) (*V5BatchCreateOrderResponse, error)
+	BatchAmendOrder(V5BatchAmendOrderParam) (*V5BatchAmendOrderResponse, error)
+	BatchCancelOrder(V5BatchCancelOrderParam) (*V5BatchCancelOrderResponse, error)
+	GetBorrowQuota(V5GetBorrowQuotaParam) (*V5GetBorrowQuotaResponse, error)
+	SetDisconnectCancelAll(V5SetDisconnectCancelAllParam) (*V5SetDisconnectCancelAllResponse, error)
 }
 
 // V5OrderService :
@@ -26,20 +34,20 @@ type V5CreateOrderParam struct {
 	Symbol    bybit.SymbolV5   `json:"symbol"`
 	Side      bybit.Side       `json:"side"`
 	OrderType bybit.OrderType  `json:"orderType"`
-	Qty       string           `json:"qty"`
+	Qty       bybit.Decimal    `json:"qty"`
 
 	IsLeverage            *bybit.IsLeverage       `json:"isLeverage,omitempty"`
-	Price                 *string                 `json:"price,omitempty"`
+	Price                 *bybit.Decimal          `json:"price,omitempty"`
 	TriggerDirection      *bybit.TriggerDirection `json:"triggerDirection,omitempty"`
 	OrderFilter           *bybit.OrderFilter      `json:"orderFilter,omitempty"` // If not passed, Order by default
-	TriggerPrice          *string                 `json:"triggerPrice,omitempty"`
+	TriggerPrice          *bybit.Decimal          `json:"triggerPrice,omitempty"`
 	TriggerBy             *bybit.TriggerBy        `json:"triggerBy,omitempty"`
-	OrderIv               *string                 `json:"orderIv,omitempty"`     // option only.
+	OrderIv               *bybit.Decimal          `json:"orderIv,omitempty"`     // option only.
 	TimeInForce           *bybit.TimeInForce      `json:"timeInForce,omitempty"` // If not passed, GTC is used by default
 	PositionIdx           *bybit.PositionIdx      `json:"positionIdx,omitempty"` // Under hedge-mode, this param is required
 	OrderLinkID           *string                 `json:"orderLinkId,omitempty"`
-	TakeProfit            *string                 `json:"takeProfit,omitempty"`
-	StopLoss              *string                 `json:"stopLoss,omitempty"`
+	TakeProfit            *bybit.Decimal          `json:"takeProfit,omitempty"`
+	StopLoss              *bybit.Decimal          `json:"stopLoss,omitempty"`
 	TpTriggerBy           *bybit.TriggerBy        `json:"tpTriggerBy,omitempty"`
 	SlTriggerBy           *bybit.TriggerBy        `json:"slTriggerBy,omitempty"`
 	ReduceOnly            *bool                   `json:"reduce_only,omitempty"`
@@ -63,12 +71,20 @@ type V5CreateOrderResult struct {
 func (s *V5OrderService) CreateOrder(param V5CreateOrderParam) (*V5CreateOrderResponse, error) {
 	var res V5CreateOrderResponse
 
+	if r := s.client.instrumentRegistry; r != nil {
+		normalized, err := r.NormalizeOrder(s.client.Context(), param)
+		if err != nil {
+			return &res, err
+		}
+		param = normalized
+	}
+
 	body, err := json.Marshal(param)
 	if err != nil {
 		return &res, fmt.Errorf("json marshal: %w", err)
 	}
 
-	if err := s.client.postV5JSON("/v5/order/create", body, &res); err != nil {
+	if err := s.client.withEndpoint("V5Order.CreateOrder").postV5JSON("/v5/order/create", body, &res); err != nil {
 		return &res, err
 	}
 
@@ -110,7 +126,7 @@ func (s *V5OrderService) CancelOrder(param V5CancelOrderParam) (*V5CancelOrderRe
 		return &res, fmt.Errorf("json marshal: %w", err)
 	}
 
-	if err := s.client.postV5JSON("/v5/order/cancel", body, &res); err != nil {
+	if err := s.client.withEndpoint("V5Order.CancelOrder").postV5JSON("/v5/order/cancel", body, &res); err != nil {
 		return &res, err
 	}
 
@@ -150,33 +166,33 @@ type V5GetOpenOrder struct {
 	OrderLinkID        string            `json:"orderLinkId"`
 	OrderID            string            `json:"orderId"`
 	CancelType         string            `json:"cancelType"`
-	AvgPrice           string            `json:"avgPrice"`
+	AvgPrice           bybit.Decimal     `json:"avgPrice"`
 	StopOrderType      string            `json:"stopOrderType"`
-	LastPriceOnCreated string            `json:"lastPriceOnCreated"`
+	LastPriceOnCreated bybit.Decimal     `json:"lastPriceOnCreated"`
 	OrderStatus        bybit.OrderStatus `json:"orderStatus"`
-	TakeProfit         string            `json:"takeProfit"`
-	CumExecValue       string            `json:"cumExecValue"`
+	TakeProfit         bybit.Decimal     `json:"takeProfit"`
+	CumExecValue       bybit.Decimal     `json:"cumExecValue"`
 	TriggerDirection   int               `json:"triggerDirection"`
 	IsLeverage         string            `json:"isLeverage"`
 	RejectReason       string            `json:"rejectReason"`
-	Price              string            `json:"price"`
-	OrderIv            string            `json:"orderIv"`
+	Price              bybit.Decimal     `json:"price"`
+	OrderIv            bybit.Decimal     `json:"orderIv"`
 	CreatedTime        string            `json:"createdTime"`
 	TpTriggerBy        string            `json:"tpTriggerBy"`
 	PositionIdx        int               `json:"positionIdx"`
 	TimeInForce        bybit.TimeInForce `json:"timeInForce"`
-	LeavesValue        string            `json:"leavesValue"`
+	LeavesValue        bybit.Decimal     `json:"leavesValue"`
 	UpdatedTime        string            `json:"updatedTime"`
 	Side               bybit.Side        `json:"side"`
-	TriggerPrice       string            `json:"triggerPrice"`
-	CumExecFee         string            `json:"cumExecFee"`
-	LeavesQty          string            `json:"leavesQty"`
+	TriggerPrice       bybit.Decimal     `json:"triggerPrice"`
+	CumExecFee         bybit.Decimal     `json:"cumExecFee"`
+	LeavesQty          bybit.Decimal     `json:"leavesQty"`
 	SlTriggerBy        string            `json:"slTriggerBy"`
 	CloseOnTrigger     bool              `json:"closeOnTrigger"`
-	CumExecQty         string            `json:"cumExecQty"`
+	CumExecQty         bybit.Decimal     `json:"cumExecQty"`
 	ReduceOnly         bool              `json:"reduceOnly"`
-	Qty                string            `json:"qty"`
-	StopLoss           string            `json:"stopLoss"`
+	Qty                bybit.Decimal     `json:"qty"`
+	StopLoss           bybit.Decimal     `json:"stopLoss"`
 	TriggerBy          bybit.TriggerBy   `json:"triggerBy"`
 }
 
@@ -193,9 +209,440 @@ func (s *V5OrderService) GetOpenOrders(param V5GetOpenOrdersParam) (*V5GetOpenOr
 		return nil, err
 	}
 
-	if err := s.client.getV5Privately("/v5/order/realtime", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("V5Order.GetOpenOrders").getV5Privately("/v5/order/realtime", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5AmendOrderParam :
+type V5AmendOrderParam struct {
+	Category bybit.CategoryV5 `json:"category"`
+	Symbol   bybit.SymbolV5   `json:"symbol"`
+
+	OrderID      *string          `json:"orderId,omitempty"`
+	OrderLinkID  *string          `json:"orderLinkId,omitempty"`
+	OrderIv      *bybit.Decimal   `json:"orderIv,omitempty"` // option only.
+	TriggerPrice *bybit.Decimal   `json:"triggerPrice,omitempty"`
+	Qty          *bybit.Decimal   `json:"qty,omitempty"`
+	Price        *bybit.Decimal   `json:"price,omitempty"`
+	TakeProfit   *bybit.Decimal   `json:"takeProfit,omitempty"`
+	StopLoss     *bybit.Decimal   `json:"stopLoss,omitempty"`
+	TpTriggerBy  *bybit.TriggerBy `json:"tpTriggerBy,omitempty"`
+	SlTriggerBy  *bybit.TriggerBy `json:"slTriggerBy,omitempty"`
+	TriggerBy    *bybit.TriggerBy `json:"triggerBy,omitempty"`
+}
+
+// V5AmendOrderResponse :
+type V5AmendOrderResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5AmendOrderResult `json:"result"`
+}
+
+// V5AmendOrderResult :
+type V5AmendOrderResult struct {
+	OrderID     string `json:"orderId"`
+	OrderLinkID string `json:"orderLinkId"`
+}
+
+// AmendOrder :
+func (s *V5OrderService) AmendOrder(param V5AmendOrderParam) (*V5AmendOrderResponse, error) {
+	var res V5AmendOrderResponse
+
+	if param.OrderID == nil && param.OrderLinkID == nil {
+		return nil, fmt.Errorf("either OrderID or OrderLinkID needed")
+	}
+
+	if r := s.client.instrumentRegistry; r != nil {
+		normalized, err := r.NormalizeAmendOrder(s.client.Context(), param)
+		if err != nil {
+			return &res, err
+		}
+		param = normalized
+	}
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5Order.AmendOrder").postV5JSON("/v5/order/amend", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5CancelAllOrdersParam :
+type V5CancelAllOrdersParam struct {
+	Category bybit.CategoryV5 `json:"category"`
+
+	Symbol      *bybit.SymbolV5    `json:"symbol,omitempty"`
+	BaseCoin    *bybit.Coin        `json:"baseCoin,omitempty"`
+	SettleCoin  *bybit.Coin        `json:"settleCoin,omitempty"`
+	OrderFilter *bybit.OrderFilter `json:"orderFilter,omitempty"` // If not passed, Order by default
+}
+
+// V5CancelAllOrdersResponse :
+type V5CancelAllOrdersResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5CancelAllOrdersResult `json:"result"`
+}
+
+// V5CancelAllOrdersResult :
+type V5CancelAllOrdersResult struct {
+	List []struct {
+		OrderID     string `json:"orderId"`
+		OrderLinkID string `json:"orderLinkId"`
+	} `json:"list"`
+}
+
+// CancelAllOrders :
+func (s *V5OrderService) CancelAllOrders(param V5CancelAllOrdersParam) (*V5CancelAllOrdersResponse, error) {
+	var res V5CancelAllOrdersResponse
+
+	if param.Category == "" {
+		return nil, fmt.Errorf("Category needed")
+	}
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5Order.CancelAllOrders").postV5JSON("/v5/order/cancel-all", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5GetOrderHistoryParam :
+type V5GetOrderHistoryParam struct {
+	Category bybit.CategoryV5 `url:"category"`
+
+	Symbol      *bybit.SymbolV5    `url:"symbol,omitempty"`
+	BaseCoin    *bybit.Coin        `url:"baseCoin,omitempty"`
+	OrderID     *string            `url:"orderId,omitempty"`
+	OrderLinkID *string            `url:"orderLinkId,omitempty"`
+	OrderFilter *bybit.OrderFilter `url:"orderFilter,omitempty"`
+	OrderStatus *bybit.OrderStatus `url:"orderStatus,omitempty"`
+	Limit       *int               `url:"limit,omitempty"` // Limit for data size per page. [1, 50]. Default: 20
+	Cursor      *string            `url:"cursor,omitempty"`
+}
+
+// V5GetOrderHistoryResponse :
+type V5GetOrderHistoryResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetOrderHistoryResult `json:"result"`
+}
+
+// V5GetOrderHistoryResult :
+// Items share the shape of V5GetOpenOrder.
+type V5GetOrderHistoryResult struct {
+	Category       bybit.CategoryV5 `json:"category"`
+	NextPageCursor string           `json:"nextPageCursor"`
+	List           []V5GetOpenOrder `json:"list"`
+}
+
+// GetOrderHistory :
+func (s *V5OrderService) GetOrderHistory(param V5GetOrderHistoryParam) (*V5GetOrderHistoryResponse, error) {
+	var res V5GetOrderHistoryResponse
+
+	if param.Category == "" {
+		return nil, fmt.Errorf("Category needed")
+	}
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Order.GetOrderHistory").getV5Privately("/v5/order/history", queryString, &res); err != nil {
 		return nil, err
 	}
 
 	return &res, nil
 }
+
+// V5BatchOrderRetExtInfo :
+// Batch endpoints report the result of each request item in the same order as submitted.
+type V5BatchOrderRetExtInfo struct {
+	List []V5BatchOrderRetExtInfoItem `json:"list"`
+}
+
+// V5BatchOrderRetExtInfoItem :
+type V5BatchOrderRetExtInfoItem struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+// V5BatchCreateOrderParam :
+type V5BatchCreateOrderParam struct {
+	Category bybit.CategoryV5              `json:"category"`
+	Request  []V5BatchCreateOrderParamItem `json:"request"`
+}
+
+// V5BatchCreateOrderParamItem :
+type V5BatchCreateOrderParamItem struct {
+	Symbol    bybit.SymbolV5  `json:"symbol"`
+	Side      bybit.Side      `json:"side"`
+	OrderType bybit.OrderType `json:"orderType"`
+	Qty       bybit.Decimal   `json:"qty"`
+
+	Price                 *bybit.Decimal          `json:"price,omitempty"`
+	TriggerDirection      *bybit.TriggerDirection `json:"triggerDirection,omitempty"`
+	TriggerPrice          *bybit.Decimal          `json:"triggerPrice,omitempty"`
+	TriggerBy             *bybit.TriggerBy        `json:"triggerBy,omitempty"`
+	OrderIv               *bybit.Decimal          `json:"orderIv,omitempty"`     // option only.
+	TimeInForce           *bybit.TimeInForce      `json:"timeInForce,omitempty"` // If not passed, GTC is used by default
+	PositionIdx           *bybit.PositionIdx      `json:"positionIdx,omitempty"` // Under hedge-mode, this param is required
+	OrderLinkID           *string                 `json:"orderLinkId,omitempty"`
+	TakeProfit            *bybit.Decimal          `json:"takeProfit,omitempty"`
+	StopLoss              *bybit.Decimal          `json:"stopLoss,omitempty"`
+	TpTriggerBy           *bybit.TriggerBy        `json:"tpTriggerBy,omitempty"`
+	SlTriggerBy           *bybit.TriggerBy        `json:"slTriggerBy,omitempty"`
+	ReduceOnly            *bool                   `json:"reduceOnly,omitempty"`
+	CloseOnTrigger        *bool                   `json:"closeOnTrigger,omitempty"`
+	MarketMakerProtection *bool                   `json:"mmp,omitempty"` // option only
+}
+
+// V5BatchCreateOrderResponse :
+type V5BatchCreateOrderResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5BatchCreateOrderResult `json:"result"`
+	RetExtInfo       V5BatchOrderRetExtInfo   `json:"retExtInfo"` // Shadows CommonV5Response.RetExtInfo on purpose, to decode the outcome of each item
+}
+
+// V5BatchCreateOrderResult :
+type V5BatchCreateOrderResult struct {
+	List []struct {
+		Category    bybit.CategoryV5 `json:"category"`
+		Symbol      bybit.SymbolV5   `json:"symbol"`
+		OrderID     string           `json:"orderId"`
+		OrderLinkID string           `json:"orderLinkId"`
+		CreateAt    string           `json:"createAt"`
+	} `json:"list"`
+}
+
+// BatchCreateOrder : up to 20 orders for option and 10 for linear, inverse and spot.
+// The outcome of each item is reported in RetExtInfo, in the order of the request
+func (s *V5OrderService) BatchCreateOrder(param V5BatchCreateOrderParam) (*V5BatchCreateOrderResponse, error) {
+	var res V5BatchCreateOrderResponse
+
+	if len(param.Request) == 0 {
+		return nil, fmt.Errorf("at least one request item needed")
+	}
+
+	if r := s.client.instrumentRegistry; r != nil {
+		normalized, err := r.NormalizeBatchOrder(s.client.Context(), param)
+		if err != nil {
+			return &res, err
+		}
+		param = normalized
+	}
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5Order.BatchCreateOrder").postV5JSON("/v5/order/create-batch", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5BatchAmendOrderParam :
+type V5BatchAmendOrderParam struct {
+	Category bybit.CategoryV5             `json:"category"`
+	Request  []V5BatchAmendOrderParamItem `json:"request"`
+}
+
+// V5BatchAmendOrderParamItem :
+type V5BatchAmendOrderParamItem struct {
+	Symbol bybit.SymbolV5 `json:"symbol"`
+
+	OrderID     *string        `json:"orderId,omitempty"`
+	OrderLinkID *string        `json:"orderLinkId,omitempty"`
+	OrderIv     *bybit.Decimal `json:"orderIv,omitempty"` // option only.
+	Qty         *bybit.Decimal `json:"qty,omitempty"`
+	Price       *bybit.Decimal `json:"price,omitempty"`
+}
+
+// V5BatchAmendOrderResponse :
+type V5BatchAmendOrderResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5BatchAmendOrderResult `json:"result"`
+	RetExtInfo       V5BatchOrderRetExtInfo  `json:"retExtInfo"` // Shadows CommonV5Response.RetExtInfo on purpose, to decode the outcome of each item
+}
+
+// V5BatchAmendOrderResult :
+type V5BatchAmendOrderResult struct {
+	List []struct {
+		Category    bybit.CategoryV5 `json:"category"`
+		Symbol      bybit.SymbolV5   `json:"symbol"`
+		OrderID     string           `json:"orderId"`
+		OrderLinkID string           `json:"orderLinkId"`
+	} `json:"list"`
+}
+
+// BatchAmendOrder : up to 20 orders for option and 10 for linear, inverse and spot.
+// The outcome of each item is reported in RetExtInfo, in the order of the request
+func (s *V5OrderService) BatchAmendOrder(param V5BatchAmendOrderParam) (*V5BatchAmendOrderResponse, error) {
+	var res V5BatchAmendOrderResponse
+
+	if len(param.Request) == 0 {
+		return nil, fmt.Errorf("at least one request item needed")
+	}
+	for _, item := range param.Request {
+		if item.OrderID == nil && item.OrderLinkID == nil {
+			return nil, fmt.Errorf("either OrderID or OrderLinkID needed")
+		}
+	}
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5Order.BatchAmendOrder").postV5JSON("/v5/order/amend-batch", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5BatchCancelOrderParam :
+type V5BatchCancelOrderParam struct {
+	Category bybit.CategoryV5              `json:"category"`
+	Request  []V5BatchCancelOrderParamItem `json:"request"`
+}
+
+// V5BatchCancelOrderParamItem :
+type V5BatchCancelOrderParamItem struct {
+	Symbol bybit.SymbolV5 `json:"symbol"`
+
+	OrderID     *string `json:"orderId,omitempty"`
+	OrderLinkID *string `json:"orderLinkId,omitempty"`
+}
+
+// V5BatchCancelOrderResponse :
+type V5BatchCancelOrderResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5BatchCancelOrderResult `json:"result"`
+	RetExtInfo       V5BatchOrderRetExtInfo   `json:"retExtInfo"` // Shadows CommonV5Response.RetExtInfo on purpose, to decode the outcome of each item
+}
+
+// V5BatchCancelOrderResult :
+type V5BatchCancelOrderResult struct {
+	List []struct {
+		Category    bybit.CategoryV5 `json:"category"`
+		Symbol      bybit.SymbolV5   `json:"symbol"`
+		OrderID     string           `json:"orderId"`
+		OrderLinkID string           `json:"orderLinkId"`
+	} `json:"list"`
+}
+
+// BatchCancelOrder : up to 20 orders for option and 10 for linear, inverse and spot.
+// The outcome of each item is reported in RetExtInfo, in the order of the request
+func (s *V5OrderService) BatchCancelOrder(param V5BatchCancelOrderParam) (*V5BatchCancelOrderResponse, error) {
+	var res V5BatchCancelOrderResponse
+
+	if len(param.Request) == 0 {
+		return nil, fmt.Errorf("at least one request item needed")
+	}
+	for _, item := range param.Request {
+		if item.OrderID == nil && item.OrderLinkID == nil {
+			return nil, fmt.Errorf("either OrderID or OrderLinkID needed")
+		}
+	}
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5Order.BatchCancelOrder").postV5JSON("/v5/order/cancel-batch", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5GetBorrowQuotaParam :
+type V5GetBorrowQuotaParam struct {
+	Category bybit.CategoryV5 `url:"category"` // spot only
+	Symbol   bybit.SymbolV5   `url:"symbol"`
+	Side     bybit.Side       `url:"side"`
+}
+
+// V5GetBorrowQuotaResponse :
+type V5GetBorrowQuotaResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetBorrowQuotaResult `json:"result"`
+}
+
+// V5GetBorrowQuotaResult :
+type V5GetBorrowQuotaResult struct {
+	Symbol             bybit.SymbolV5 `json:"symbol"`
+	Side               bybit.Side     `json:"side"`
+	MaxTradeQty        bybit.Decimal  `json:"maxTradeQty"`
+	MaxTradeAmount     bybit.Decimal  `json:"maxTradeAmount"`
+	SpotMaxTradeQty    bybit.Decimal  `json:"spotMaxTradeQty"`
+	SpotMaxTradeAmount bybit.Decimal  `json:"spotMaxTradeAmount"`
+	BorrowCoin         bybit.Coin     `json:"borrowCoin"`
+}
+
+// GetBorrowQuota :
+func (s *V5OrderService) GetBorrowQuota(param V5GetBorrowQuotaParam) (*V5GetBorrowQuotaResponse, error) {
+	var res V5GetBorrowQuotaResponse
+
+	if param.Category != bybit.CategoryV5Spot {
+		return nil, fmt.Errorf("category should be spot")
+	}
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Order.GetBorrowQuota").getV5Privately("/v5/order/spot-borrow-check", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5SetDisconnectCancelAllParam :
+type V5SetDisconnectCancelAllParam struct {
+	TimeWindow int `json:"timeWindow"` // Disconnection timing window time. [10, 300], unit: second
+}
+
+// V5SetDisconnectCancelAllResponse :
+type V5SetDisconnectCancelAllResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           interface{} `json:"result"`
+}
+
+// SetDisconnectCancelAll : option only
+func (s *V5OrderService) SetDisconnectCancelAll(param V5SetDisconnectCancelAllParam) (*V5SetDisconnectCancelAllResponse, error) {
+	var res V5SetDisconnectCancelAllResponse
+
+	if param.TimeWindow < 10 || param.TimeWindow > 300 {
+		return nil, fmt.Errorf("TimeWindow should be in [10, 300]")
+	}
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5Order.SetDisconnectCancelAll").postV5JSON("/v5/order/disconnected-cancel-all", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,225 @@
+package rest
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrPagerBudgetExceeded : returned by V5Pager when the max page or max item budget stops the walk early
+var ErrPagerBudgetExceeded = errors.New("pager budget exceeded")
+
+// V5PageFunc : fetches one page for the given cursor and returns its items with the next page cursor.
+// A nil cursor requests the first page.
+type V5PageFunc[T any] func(cursor *string) ([]T, string, error)
+
+// V5Pager : walks every page of a V5 list endpoint paginated by cursor
+type V5Pager[T any] struct {
+	fetch    V5PageFunc[T]
+	maxPages int
+	maxItems int
+}
+
+// NewV5Pager :
+func NewV5Pager[T any](fetch V5PageFunc[T]) *V5Pager[T] {
+	return &V5Pager[T]{fetch: fetch}
+}
+
+// WithMaxPages : 0 means unlimited
+func (p *V5Pager[T]) WithMaxPages(n int) *V5Pager[T] {
+	p.maxPages = n
+
+	return p
+}
+
+// WithMaxItems : 0 means unlimited
+func (p *V5Pager[T]) WithMaxItems(n int) *V5Pager[T] {
+	p.maxItems = n
+
+	return p
+}
+
+// Each : calls f for every item until the last page, f returns an error or ctx is done.
+// When the budget stops the walk before the last page, ErrPagerBudgetExceeded is returned.
+// ctx is checked between pages, build the service from Client.WithContext(ctx) to also abort an in-flight page.
+func (p *V5Pager[T]) Each(ctx context.Context, f func(T) error) error {
+	var (
+		cursor *string
+		pages  int
+		items  int
+	)
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		// the budget is checked before fetching, so that no page is fetched only to be discarded
+		if (p.maxPages > 0 && pages >= p.maxPages) || (p.maxItems > 0 && items >= p.maxItems) {
+			return ErrPagerBudgetExceeded
+		}
+
+		list, next, err := p.fetch(cursor)
+		if err != nil {
+			return err
+		}
+		pages++
+
+		for _, item := range list {
+			if p.maxItems > 0 && items >= p.maxItems {
+				return ErrPagerBudgetExceeded
+			}
+			if err := f(item); err != nil {
+				return err
+			}
+			items++
+		}
+
+		if next == "" || (cursor != nil && *cursor == next) {
+			return nil
+		}
+		cursor = &next
+	}
+}
+
+// All : collects every item. Items fetched before a budget or context error are returned along with it.
+func (p *V5Pager[T]) All(ctx context.Context) ([]T, error) {
+	var result []T
+
+	err := p.Each(ctx, func(item T) error {
+		result = append(result, item)
+		return nil
+	})
+
+	return result, err
+}
+
+// V5PositionInfoPager :
+func V5PositionInfoPager(s V5PositionServiceI, param V5GetPositionInfoParam) *V5Pager[V5GetPositionInfoItem] {
+	return NewV5Pager(func(cursor *string) ([]V5GetPositionInfoItem, string, error) {
+		param.Cursor = cursor
+		res, err := s.GetPositionInfo(param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.List, res.Result.NextPageCursor, nil
+	})
+}
+
+// V5OpenOrdersPager :
+func V5OpenOrdersPager(s V5OrderServiceI, param V5GetOpenOrdersParam) *V5Pager[V5GetOpenOrder] {
+	return NewV5Pager(func(cursor *string) ([]V5GetOpenOrder, string, error) {
+		param.Cursor = cursor
+		res, err := s.GetOpenOrders(param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.List, res.Result.NextPageCursor, nil
+	})
+}
+
+// V5OrderHistoryPager :
+func V5OrderHistoryPager(s V5OrderServiceI, param V5GetOrderHistoryParam) *V5Pager[V5GetOpenOrder] {
+	return NewV5Pager(func(cursor *string) ([]V5GetOpenOrder, string, error) {
+		param.Cursor = cursor
+		res, err := s.GetOrderHistory(param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.List, res.Result.NextPageCursor, nil
+	})
+}
+
+// V5ExecutionListPager :
+func V5ExecutionListPager(s V5ExecutionServiceI, param V5GetExecutionListParam) *V5Pager[V5GetExecutionListItem] {
+	return NewV5Pager(func(cursor *string) ([]V5GetExecutionListItem, string, error) {
+		param.Cursor = cursor
+		res, err := s.GetExecutionList(param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.List, res.Result.NextPageCursor, nil
+	})
+}
+
+// V5ClosedPnLPager :
+func V5ClosedPnLPager(s V5PositionServiceI, param V5GetClosedPnLParam) *V5Pager[V5GetClosedPnLItem] {
+	return NewV5Pager(func(cursor *string) ([]V5GetClosedPnLItem, string, error) {
+		param.Cursor = cursor
+		res, err := s.GetClosedPnL(param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.List, res.Result.NextPageCursor, nil
+	})
+}
+
+// V5TransactionLogPager :
+func V5TransactionLogPager(s V5AccountServiceI, param V5GetTransactionLogParam) *V5Pager[V5GetTransactionLogItem] {
+	return NewV5Pager(func(cursor *string) ([]V5GetTransactionLogItem, string, error) {
+		param.Cursor = cursor
+		res, err := s.GetTransactionLog(param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.List, res.Result.NextPageCursor, nil
+	})
+}
+
+// V5BorrowHistoryPager :
+func V5BorrowHistoryPager(s V5AccountServiceI, param V5GetBorrowHistoryParam) *V5Pager[V5GetBorrowHistoryItem] {
+	return NewV5Pager(func(cursor *string) ([]V5GetBorrowHistoryItem, string, error) {
+		param.Cursor = cursor
+		res, err := s.GetBorrowHistory(param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.List, res.Result.NextPageCursor, nil
+	})
+}
+
+// V5InternalTransferRecordsPager :
+func V5InternalTransferRecordsPager(s V5AssetServiceI, param V5GetInternalTransferRecordsParam) *V5Pager[V5GetInternalTransferRecordsItem] {
+	return NewV5Pager(func(cursor *string) ([]V5GetInternalTransferRecordsItem, string, error) {
+		param.Cursor = cursor
+		res, err := s.GetInternalTransferRecords(param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.List, res.Result.NextPageCursor, nil
+	})
+}
+
+// V5UniversalTransferRecordsPager :
+func V5UniversalTransferRecordsPager(s V5AssetServiceI, param V5GetUniversalTransferRecordsParam) *V5Pager[V5GetUniversalTransferRecordsItem] {
+	return NewV5Pager(func(cursor *string) ([]V5GetUniversalTransferRecordsItem, string, error) {
+		param.Cursor = cursor
+		res, err := s.GetUniversalTransferRecords(param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.List, res.Result.NextPageCursor, nil
+	})
+}
+
+// V5DepositRecordsPager :
+func V5DepositRecordsPager(s V5AssetServiceI, param V5GetDepositRecordsParam) *V5Pager[V5DepositRecordRow] {
+	return NewV5Pager(func(cursor *string) ([]V5DepositRecordRow, string, error) {
+		param.Cursor = cursor
+		res, err := s.GetDepositRecords(param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.Rows, res.Result.NextPageCursor, nil
+	})
+}
+
+// V5WithdrawalRecordsPager :
+func V5WithdrawalRecordsPager(s V5AssetServiceI, param V5GetWithdrawalRecordsParam) *V5Pager[V5WithdrawalRecordRow] {
+	return NewV5Pager(func(cursor *string) ([]V5WithdrawalRecordRow, string, error) {
+		param.Cursor = cursor
+		res, err := s.GetWithdrawalRecords(param)
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Result.Rows, res.Result.NextPageCursor, nil
+	})
+}
@@ -1,6 +1,9 @@
 package rest
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"github.com/google/go-querystring/query"
 	"github.com/sngyai/go-bybit"
 )
@@ -8,6 +11,15 @@ import (
 // V5PositionServiceI :
 type V5PositionServiceI interface {
 	GetPositionInfo(V5GetPositionInfoParam) (*V5GetPositionInfoResponse, error)
+	SetLeverage(V5SetLeverageParam) (*V5SetLeverageResponse, error)
+	SwitchIsolated(V5SwitchIsolatedParam) (*V5SwitchIsolatedResponse, error)
+	SwitchPositionMode(V5SwitchPositionModeParam) (*V5SwitchPositionModeResponse, error)
+	SetTpSlMode(V5SetTpSlModeParam) (*V5SetTpSlModeResponse, error)
+	SetRiskLimit(V5SetRiskLimitParam) (*V5SetRiskLimitResponse, error)
+	SetTradingStop(V5SetTradingStopParam) (*V5SetTradingStopResponse, error)
+	SetAutoAddMargin(V5SetAutoAddMarginParam) (*V5SetAutoAddMarginResponse, error)
+	AddOrReduceMargin(V5AddOrReduceMarginParam) (*V5AddOrReduceMarginResponse, error)
+	GetClosedPnL(V5GetClosedPnLParam) (*V5GetClosedPnLResponse, error)
 }
 
 // V5PositionService :
@@ -45,28 +57,28 @@ type V5GetPositionInfoList []V5GetPositionInfoItem
 // V5GetPositionInfoItem :
 type V5GetPositionInfoItem struct {
 	Symbol         bybit.SymbolV5 `json:"symbol"`
-	Leverage       string         `json:"leverage"`
-	AvgPrice       string         `json:"avgPrice"`
-	LiqPrice       string         `json:"liqPrice"`
-	RiskLimitValue string         `json:"riskLimitValue"`
-	TakeProfit     string         `json:"takeProfit"`
-	PositionValue  string         `json:"positionValue"`
+	Leverage       bybit.Decimal  `json:"leverage"`
+	AvgPrice       bybit.Decimal  `json:"avgPrice"`
+	LiqPrice       bybit.Decimal  `json:"liqPrice"`
+	RiskLimitValue bybit.Decimal  `json:"riskLimitValue"`
+	TakeProfit     bybit.Decimal  `json:"takeProfit"`
+	PositionValue  bybit.Decimal  `json:"positionValue"`
 	TpSlMode       bybit.TpSlMode `json:"tpslMode"`
 	RiskID         int            `json:"riskId"`
-	TrailingStop   string         `json:"trailingStop"`
-	UnrealisedPnl  string         `json:"unrealisedPnl"`
-	MarkPrice      string         `json:"markPrice"`
-	CumRealisedPnl string         `json:"cumRealisedPnl"`
-	PositionMM     string         `json:"positionMM"`
+	TrailingStop   bybit.Decimal  `json:"trailingStop"`
+	UnrealisedPnl  bybit.Decimal  `json:"unrealisedPnl"`
+	MarkPrice      bybit.Decimal  `json:"markPrice"`
+	CumRealisedPnl bybit.Decimal  `json:"cumRealisedPnl"`
+	PositionMM     bybit.Decimal  `json:"positionMM"`
 	CreatedTime    string         `json:"createdTime"`
 	PositionIdx    int            `json:"positionIdx"`
-	PositionIM     string         `json:"positionIM"`
+	PositionIM     bybit.Decimal  `json:"positionIM"`
 	UpdatedTime    string         `json:"updatedTime"`
 	Side           bybit.Side     `json:"side"`
-	BustPrice      string         `json:"bustPrice"`
-	Size           string         `json:"size"`
+	BustPrice      bybit.Decimal  `json:"bustPrice"`
+	Size           bybit.Decimal  `json:"size"`
 	PositionStatus string         `json:"positionStatus"`
-	StopLoss       string         `json:"stopLoss"`
+	StopLoss       bybit.Decimal  `json:"stopLoss"`
 	TradeMode      int            `json:"tradeMode"`
 }
 
@@ -79,7 +91,368 @@ func (s *V5PositionService) GetPositionInfo(param V5GetPositionInfoParam) (*V5Ge
 		return nil, err
 	}
 
-	if err := s.client.getV5Privately("/v5/position/list", queryString, &res); err != nil {
+	if err := s.client.withEndpoint("V5Position.GetPositionInfo").getV5Privately("/v5/position/list", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5SetLeverageParam :
+type V5SetLeverageParam struct {
+	Category     bybit.CategoryV5 `json:"category"`
+	Symbol       bybit.SymbolV5   `json:"symbol"`
+	BuyLeverage  bybit.Decimal    `json:"buyLeverage"`
+	SellLeverage bybit.Decimal    `json:"sellLeverage"` // Under one-way mode, buyLeverage must be the same as sellLeverage
+}
+
+// V5SetLeverageResponse :
+type V5SetLeverageResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           interface{} `json:"result"`
+}
+
+// SetLeverage :
+func (s *V5PositionService) SetLeverage(param V5SetLeverageParam) (*V5SetLeverageResponse, error) {
+	var res V5SetLeverageResponse
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5Position.SetLeverage").postV5JSON("/v5/position/set-leverage", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5SwitchIsolatedParam :
+type V5SwitchIsolatedParam struct {
+	Category     bybit.CategoryV5  `json:"category"`
+	Symbol       bybit.SymbolV5    `json:"symbol"`
+	TradeMode    bybit.TradeModeV5 `json:"tradeMode"`
+	BuyLeverage  bybit.Decimal     `json:"buyLeverage"`
+	SellLeverage bybit.Decimal     `json:"sellLeverage"`
+}
+
+// V5SwitchIsolatedResponse :
+type V5SwitchIsolatedResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           interface{} `json:"result"`
+}
+
+// SwitchIsolated :
+func (s *V5PositionService) SwitchIsolated(param V5SwitchIsolatedParam) (*V5SwitchIsolatedResponse, error) {
+	var res V5SwitchIsolatedResponse
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5Position.SwitchIsolated").postV5JSON("/v5/position/switch-isolated", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5SwitchPositionModeParam :
+// Either symbol or coin is required. symbol has a higher priority
+type V5SwitchPositionModeParam struct {
+	Category bybit.CategoryV5   `json:"category"`
+	Mode     bybit.PositionMode `json:"mode"`
+
+	Symbol *bybit.SymbolV5 `json:"symbol,omitempty"`
+	Coin   *bybit.Coin     `json:"coin,omitempty"`
+}
+
+// V5SwitchPositionModeResponse :
+type V5SwitchPositionModeResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           interface{} `json:"result"`
+}
+
+// SwitchPositionMode :
+func (s *V5PositionService) SwitchPositionMode(param V5SwitchPositionModeParam) (*V5SwitchPositionModeResponse, error) {
+	var res V5SwitchPositionModeResponse
+
+	if param.Symbol == nil && param.Coin == nil {
+		return nil, fmt.Errorf("either Symbol or Coin needed")
+	}
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5Position.SwitchPositionMode").postV5JSON("/v5/position/switch-mode", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5SetTpSlModeParam :
+type V5SetTpSlModeParam struct {
+	Category bybit.CategoryV5 `json:"category"`
+	Symbol   bybit.SymbolV5   `json:"symbol"`
+	TpSlMode bybit.TpSlMode   `json:"tpSlMode"`
+}
+
+// V5SetTpSlModeResponse :
+type V5SetTpSlModeResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5SetTpSlModeResult `json:"result"`
+}
+
+// V5SetTpSlModeResult :
+type V5SetTpSlModeResult struct {
+	TpSlMode bybit.TpSlMode `json:"tpSlMode"`
+}
+
+// SetTpSlMode :
+func (s *V5PositionService) SetTpSlMode(param V5SetTpSlModeParam) (*V5SetTpSlModeResponse, error) {
+	var res V5SetTpSlModeResponse
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5Position.SetTpSlMode").postV5JSON("/v5/position/set-tpsl-mode", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5SetRiskLimitParam :
+type V5SetRiskLimitParam struct {
+	Category bybit.CategoryV5 `json:"category"`
+	Symbol   bybit.SymbolV5   `json:"symbol"`
+	RiskID   int              `json:"riskId"`
+
+	PositionIdx *bybit.PositionIdx `json:"positionIdx,omitempty"` // Under hedge-mode, this param is required
+}
+
+// V5SetRiskLimitResponse :
+type V5SetRiskLimitResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5SetRiskLimitResult `json:"result"`
+}
+
+// V5SetRiskLimitResult :
+type V5SetRiskLimitResult struct {
+	Category       bybit.CategoryV5 `json:"category"`
+	RiskID         int              `json:"riskId"`
+	RiskLimitValue bybit.Decimal    `json:"riskLimitValue"`
+}
+
+// SetRiskLimit :
+func (s *V5PositionService) SetRiskLimit(param V5SetRiskLimitParam) (*V5SetRiskLimitResponse, error) {
+	var res V5SetRiskLimitResponse
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5Position.SetRiskLimit").postV5JSON("/v5/position/set-risk-limit", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5SetTradingStopParam :
+type V5SetTradingStopParam struct {
+	Category    bybit.CategoryV5  `json:"category"`
+	Symbol      bybit.SymbolV5    `json:"symbol"`
+	PositionIdx bybit.PositionIdx `json:"positionIdx"`
+
+	TakeProfit   *bybit.Decimal   `json:"takeProfit,omitempty"`   // Cannot be less than 0, 0 means cancel TP
+	StopLoss     *bybit.Decimal   `json:"stopLoss,omitempty"`     // Cannot be less than 0, 0 means cancel SL
+	TrailingStop *bybit.Decimal   `json:"trailingStop,omitempty"` // Cannot be less than 0, 0 means cancel TS
+	TpTriggerBy  *bybit.TriggerBy `json:"tpTriggerBy,omitempty"`
+	SlTriggerBy  *bybit.TriggerBy `json:"slTriggerBy,omitempty"`
+	ActivePrice  *bybit.Decimal   `json:"activePrice,omitempty"` // Trailing stop trigger price
+	TpSize       *bybit.Decimal   `json:"tpSize,omitempty"`      // Take profit size. valid for TP/SL partial mode
+	SlSize       *bybit.Decimal   `json:"slSize,omitempty"`      // Stop loss size. valid for TP/SL partial mode
+}
+
+// V5SetTradingStopResponse :
+type V5SetTradingStopResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           interface{} `json:"result"`
+}
+
+// SetTradingStop :
+func (s *V5PositionService) SetTradingStop(param V5SetTradingStopParam) (*V5SetTradingStopResponse, error) {
+	var res V5SetTradingStopResponse
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5Position.SetTradingStop").postV5JSON("/v5/position/trading-stop", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5SetAutoAddMarginParam :
+type V5SetAutoAddMarginParam struct {
+	Category      bybit.CategoryV5 `json:"category"`
+	Symbol        bybit.SymbolV5   `json:"symbol"`
+	AutoAddMargin int              `json:"autoAddMargin"` // 0: off. 1: on
+
+	PositionIdx *bybit.PositionIdx `json:"positionIdx,omitempty"` // Under hedge-mode, this param is required
+}
+
+// V5SetAutoAddMarginResponse :
+type V5SetAutoAddMarginResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           interface{} `json:"result"`
+}
+
+// SetAutoAddMargin :
+func (s *V5PositionService) SetAutoAddMargin(param V5SetAutoAddMarginParam) (*V5SetAutoAddMarginResponse, error) {
+	var res V5SetAutoAddMarginResponse
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5Position.SetAutoAddMargin").postV5JSON("/v5/position/set-auto-add-margin", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5AddOrReduceMarginParam :
+type V5AddOrReduceMarginParam struct {
+	Category bybit.CategoryV5 `json:"category"`
+	Symbol   bybit.SymbolV5   `json:"symbol"`
+	Margin   bybit.Decimal    `json:"margin"` // Add or reduce. To add, then 10; To reduce, then -10
+
+	PositionIdx *bybit.PositionIdx `json:"positionIdx,omitempty"` // Under hedge-mode, this param is required
+}
+
+// V5AddOrReduceMarginResponse :
+type V5AddOrReduceMarginResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5AddOrReduceMarginResult `json:"result"`
+}
+
+// V5AddOrReduceMarginResult :
+type V5AddOrReduceMarginResult struct {
+	Category       bybit.CategoryV5 `json:"category"`
+	Symbol         bybit.SymbolV5   `json:"symbol"`
+	PositionIdx    int              `json:"positionIdx"`
+	RiskID         int              `json:"riskId"`
+	RiskLimitValue bybit.Decimal    `json:"riskLimitValue"`
+	Size           bybit.Decimal    `json:"size"`
+	AvgPrice       bybit.Decimal    `json:"avgPrice"`
+	LiqPrice       bybit.Decimal    `json:"liqPrice"`
+	BustPrice      bybit.Decimal    `json:"bustPrice"`
+	MarkPrice      bybit.Decimal    `json:"markPrice"`
+	PositionValue  bybit.Decimal    `json:"positionValue"`
+	Leverage       bybit.Decimal    `json:"leverage"`
+	AutoAddMargin  int              `json:"autoAddMargin"`
+	PositionStatus string           `json:"positionStatus"`
+	PositionIM     bybit.Decimal    `json:"positionIM"`
+	PositionMM     bybit.Decimal    `json:"positionMM"`
+	TakeProfit     bybit.Decimal    `json:"takeProfit"`
+	StopLoss       bybit.Decimal    `json:"stopLoss"`
+	TrailingStop   bybit.Decimal    `json:"trailingStop"`
+	UnrealisedPnl  bybit.Decimal    `json:"unrealisedPnl"`
+	CumRealisedPnl bybit.Decimal    `json:"cumRealisedPnl"`
+	CreatedTime    string           `json:"createdTime"`
+	UpdatedTime    string           `json:"updatedTime"`
+}
+
+// AddOrReduceMargin :
+func (s *V5PositionService) AddOrReduceMargin(param V5AddOrReduceMarginParam) (*V5AddOrReduceMarginResponse, error) {
+	var res V5AddOrReduceMarginResponse
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5Position.AddOrReduceMargin").postV5JSON("/v5/position/add-margin", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5GetClosedPnLParam :
+type V5GetClosedPnLParam struct {
+	Category bybit.CategoryV5 `url:"category"`
+
+	Symbol    *bybit.SymbolV5 `url:"symbol,omitempty"`
+	StartTime *int64          `url:"startTime,omitempty"` // The start timestamp (ms)
+	EndTime   *int64          `url:"endTime,omitempty"`   // The end timestamp (ms)
+	Limit     *int            `url:"limit,omitempty"`     // Limit for data size per page. [1, 100]. Default: 50
+	Cursor    *string         `url:"cursor,omitempty"`
+}
+
+// V5GetClosedPnLResponse :
+type V5GetClosedPnLResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetClosedPnLResult `json:"result"`
+}
+
+// V5GetClosedPnLResult :
+type V5GetClosedPnLResult struct {
+	Category       bybit.CategoryV5     `json:"category"`
+	NextPageCursor string               `json:"nextPageCursor"`
+	List           []V5GetClosedPnLItem `json:"list"`
+}
+
+// V5GetClosedPnLItem :
+type V5GetClosedPnLItem struct {
+	Symbol        bybit.SymbolV5  `json:"symbol"`
+	OrderID       string          `json:"orderId"`
+	Side          bybit.Side      `json:"side"`
+	Qty           bybit.Decimal   `json:"qty"`
+	OrderPrice    bybit.Decimal   `json:"orderPrice"`
+	OrderType     bybit.OrderType `json:"orderType"`
+	ExecType      bybit.ExecType  `json:"execType"`
+	ClosedSize    bybit.Decimal   `json:"closedSize"`
+	CumEntryValue bybit.Decimal   `json:"cumEntryValue"`
+	AvgEntryPrice bybit.Decimal   `json:"avgEntryPrice"`
+	CumExitValue  bybit.Decimal   `json:"cumExitValue"`
+	AvgExitPrice  bybit.Decimal   `json:"avgExitPrice"`
+	ClosedPnl     bybit.Decimal   `json:"closedPnl"`
+	FillCount     string          `json:"fillCount"`
+	Leverage      bybit.Decimal   `json:"leverage"`
+	CreatedTime   string          `json:"createdTime"`
+	UpdatedTime   string          `json:"updatedTime"`
+}
+
+// GetClosedPnL :
+func (s *V5PositionService) GetClosedPnL(param V5GetClosedPnLParam) (*V5GetClosedPnLResponse, error) {
+	var res V5GetClosedPnLResponse
+
+	if param.Category == "" {
+		return nil, fmt.Errorf("Category needed")
+	}
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5Position.GetClosedPnL").getV5Privately("/v5/position/closed-pnl", queryString, &res); err != nil {
 		return nil, err
 	}
 
@@ -1,10 +1,255 @@
 package rest
 
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/go-querystring/query"
+	"github.com/sngyai/go-bybit"
+)
+
 // V5SpotLeverageTokenServiceI :
 type V5SpotLeverageTokenServiceI interface {
+	GetLeverageTokenInfo(V5GetLeverageTokenInfoParam) (*V5GetLeverageTokenInfoResponse, error)
+	GetLeverageTokenMarket(V5GetLeverageTokenMarketParam) (*V5GetLeverageTokenMarketResponse, error)
+	PurchaseLeverageToken(V5PurchaseLeverageTokenParam) (*V5PurchaseLeverageTokenResponse, error)
+	RedeemLeverageToken(V5RedeemLeverageTokenParam) (*V5RedeemLeverageTokenResponse, error)
+	GetLeverageTokenOrderRecords(V5GetLeverageTokenOrderRecordsParam) (*V5GetLeverageTokenOrderRecordsResponse, error)
 }
 
 // V5SpotLeverageTokenService :
 type V5SpotLeverageTokenService struct {
 	client *Client
 }
+
+// V5GetLeverageTokenInfoParam :
+type V5GetLeverageTokenInfoParam struct {
+	LtCoin *bybit.Coin `url:"ltCoin,omitempty"` // Abbreviation of the LT, such as BTC3L
+}
+
+// V5GetLeverageTokenInfoResponse :
+type V5GetLeverageTokenInfoResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetLeverageTokenInfoResult `json:"result"`
+}
+
+// V5GetLeverageTokenInfoResult :
+type V5GetLeverageTokenInfoResult struct {
+	List []V5GetLeverageTokenInfoItem `json:"list"`
+}
+
+// V5GetLeverageTokenInfoItem :
+type V5GetLeverageTokenInfoItem struct {
+	LtCoin           bybit.Coin    `json:"ltCoin"`
+	LtName           string        `json:"ltName"`
+	MaxPurchase      bybit.Decimal `json:"maxPurchase"`
+	MinPurchase      bybit.Decimal `json:"minPurchase"`
+	MaxPurchaseDaily bybit.Decimal `json:"maxPurchaseDaily"`
+	MaxRedeem        bybit.Decimal `json:"maxRedeem"`
+	MinRedeem        bybit.Decimal `json:"minRedeem"`
+	MaxRedeemDaily   bybit.Decimal `json:"maxRedeemDaily"`
+	PurchaseFeeRate  bybit.Decimal `json:"purchaseFeeRate"`
+	RedeemFeeRate    bybit.Decimal `json:"redeemFeeRate"`
+	LtStatus         string        `json:"ltStatus"`
+	FundFee          bybit.Decimal `json:"fundFee"`
+	FundFeeTime      string        `json:"fundFeeTime"`
+	ManageFeeRate    bybit.Decimal `json:"manageFeeRate"`
+	ManageFeeTime    string        `json:"manageFeeTime"`
+	Value            bybit.Decimal `json:"value"`
+	NetValue         bybit.Decimal `json:"netValue"`
+	Total            bybit.Decimal `json:"total"`
+}
+
+// GetLeverageTokenInfo :
+func (s *V5SpotLeverageTokenService) GetLeverageTokenInfo(param V5GetLeverageTokenInfoParam) (*V5GetLeverageTokenInfoResponse, error) {
+	var res V5GetLeverageTokenInfoResponse
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5SpotLeverageToken.GetLeverageTokenInfo").getPublicly("/v5/spot-lever-token/info", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5GetLeverageTokenMarketParam :
+type V5GetLeverageTokenMarketParam struct {
+	LtCoin bybit.Coin `url:"ltCoin"`
+}
+
+// V5GetLeverageTokenMarketResponse :
+type V5GetLeverageTokenMarketResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetLeverageTokenMarketResult `json:"result"`
+}
+
+// V5GetLeverageTokenMarketResult :
+type V5GetLeverageTokenMarketResult struct {
+	LtCoin      bybit.Coin    `json:"ltCoin"`
+	Nav         bybit.Decimal `json:"nav"`
+	NavTime     string        `json:"navTime"`
+	Circulation bybit.Decimal `json:"circulation"`
+	Basket      string        `json:"basket"`
+	Leverage    bybit.Decimal `json:"leverage"`
+}
+
+// GetLeverageTokenMarket :
+func (s *V5SpotLeverageTokenService) GetLeverageTokenMarket(param V5GetLeverageTokenMarketParam) (*V5GetLeverageTokenMarketResponse, error) {
+	var res V5GetLeverageTokenMarketResponse
+
+	if param.LtCoin == "" {
+		return nil, fmt.Errorf("LtCoin needed")
+	}
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5SpotLeverageToken.GetLeverageTokenMarket").getPublicly("/v5/spot-lever-token/reference", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5PurchaseLeverageTokenParam :
+type V5PurchaseLeverageTokenParam struct {
+	LtCoin bybit.Coin    `json:"ltCoin"`
+	Amount bybit.Decimal `json:"amount"` // Purchase amount
+
+	SerialNo *string `json:"serialNo,omitempty"` // Customised order ID, used to prevent from replay
+}
+
+// V5PurchaseLeverageTokenResponse :
+type V5PurchaseLeverageTokenResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5PurchaseLeverageTokenResult `json:"result"`
+}
+
+// V5PurchaseLeverageTokenResult :
+type V5PurchaseLeverageTokenResult struct {
+	LtCoin        bybit.Coin    `json:"ltCoin"`
+	LtOrderStatus string        `json:"ltOrderStatus"`
+	ExecQty       bybit.Decimal `json:"execQty"`
+	ExecAmt       bybit.Decimal `json:"execAmt"`
+	Amount        bybit.Decimal `json:"amount"`
+	PurchaseID    string        `json:"purchaseId"`
+	SerialNo      string        `json:"serialNo"`
+	ValueCoin     bybit.Coin    `json:"valueCoin"`
+}
+
+// PurchaseLeverageToken :
+func (s *V5SpotLeverageTokenService) PurchaseLeverageToken(param V5PurchaseLeverageTokenParam) (*V5PurchaseLeverageTokenResponse, error) {
+	var res V5PurchaseLeverageTokenResponse
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5SpotLeverageToken.PurchaseLeverageToken").postV5JSON("/v5/spot-lever-token/purchase", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5RedeemLeverageTokenParam :
+type V5RedeemLeverageTokenParam struct {
+	LtCoin   bybit.Coin    `json:"ltCoin"`
+	Quantity bybit.Decimal `json:"quantity"` // Redeem quantity of LT
+
+	SerialNo *string `json:"serialNo,omitempty"` // Customised order ID, used to prevent from replay
+}
+
+// V5RedeemLeverageTokenResponse :
+type V5RedeemLeverageTokenResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5RedeemLeverageTokenResult `json:"result"`
+}
+
+// V5RedeemLeverageTokenResult :
+type V5RedeemLeverageTokenResult struct {
+	LtCoin        bybit.Coin    `json:"ltCoin"`
+	LtOrderStatus string        `json:"ltOrderStatus"`
+	Quantity      bybit.Decimal `json:"quantity"`
+	ExecQty       bybit.Decimal `json:"execQty"`
+	ExecAmt       bybit.Decimal `json:"execAmt"`
+	RedeemID      string        `json:"redeemId"`
+	SerialNo      string        `json:"serialNo"`
+	ValueCoin     bybit.Coin    `json:"valueCoin"`
+}
+
+// RedeemLeverageToken :
+func (s *V5SpotLeverageTokenService) RedeemLeverageToken(param V5RedeemLeverageTokenParam) (*V5RedeemLeverageTokenResponse, error) {
+	var res V5RedeemLeverageTokenResponse
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5SpotLeverageToken.RedeemLeverageToken").postV5JSON("/v5/spot-lever-token/redeem", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5GetLeverageTokenOrderRecordsParam :
+type V5GetLeverageTokenOrderRecordsParam struct {
+	LtCoin      *bybit.Coin `url:"ltCoin,omitempty"`
+	OrderID     *string     `url:"orderId,omitempty"`
+	StartTime   *int64      `url:"startTime,omitempty"`   // The start timestamp (ms)
+	EndTime     *int64      `url:"endTime,omitempty"`     // The end timestamp (ms)
+	Limit       *int        `url:"limit,omitempty"`       // Limit for data size per page. [1, 500]. Default: 100
+	LtOrderType *int        `url:"ltOrderType,omitempty"` // 1: purchase, 2: redemption
+	SerialNo    *string     `url:"serialNo,omitempty"`
+}
+
+// V5GetLeverageTokenOrderRecordsResponse :
+type V5GetLeverageTokenOrderRecordsResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetLeverageTokenOrderRecordsResult `json:"result"`
+}
+
+// V5GetLeverageTokenOrderRecordsResult :
+type V5GetLeverageTokenOrderRecordsResult struct {
+	List []V5GetLeverageTokenOrderRecordsItem `json:"list"`
+}
+
+// V5GetLeverageTokenOrderRecordsItem :
+type V5GetLeverageTokenOrderRecordsItem struct {
+	LtCoin        bybit.Coin    `json:"ltCoin"`
+	OrderID       string        `json:"orderId"`
+	LtOrderType   int           `json:"ltOrderType"`
+	OrderTime     int64         `json:"orderTime"`
+	UpdateTime    int64         `json:"updateTime"`
+	LtOrderStatus string        `json:"ltOrderStatus"`
+	Fee           bybit.Decimal `json:"fee"`
+	Amount        bybit.Decimal `json:"amount"`
+	Value         bybit.Decimal `json:"value"`
+	ValueCoin     bybit.Coin    `json:"valueCoin"`
+	SerialNo      string        `json:"serialNo"`
+}
+
+// GetLeverageTokenOrderRecords :
+func (s *V5SpotLeverageTokenService) GetLeverageTokenOrderRecords(param V5GetLeverageTokenOrderRecordsParam) (*V5GetLeverageTokenOrderRecordsResponse, error) {
+	var res V5GetLeverageTokenOrderRecordsResponse
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5SpotLeverageToken.GetLeverageTokenOrderRecords").getV5Privately("/v5/spot-lever-token/order-record", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
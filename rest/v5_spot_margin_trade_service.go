@@ -1,10 +1,305 @@
 package rest
 
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/google/go-querystring/query"
+	"github.com/sngyai/go-bybit"
+)
+
 // V5SpotMarginTradeServiceI :
 type V5SpotMarginTradeServiceI interface {
+	// unified account
+	GetVIPMarginData(V5GetVIPMarginDataParam) (*V5GetVIPMarginDataResponse, error)
+	ToggleMarginTrade(V5ToggleMarginTradeParam) (*V5ToggleMarginTradeResponse, error)
+	SetLeverage(V5SpotMarginSetLeverageParam) (*V5SpotMarginSetLeverageResponse, error)
+	GetStatusAndLeverage() (*V5GetStatusAndLeverageResponse, error)
+
+	// classic account
+	ToggleMarginTradeNormal(V5ToggleMarginTradeNormalParam) (*V5ToggleMarginTradeNormalResponse, error)
+	GetBorrowableCoinInfo(V5GetBorrowableCoinInfoParam) (*V5GetBorrowableCoinInfoResponse, error)
+	GetInterestQuota(V5GetInterestQuotaParam) (*V5GetInterestQuotaResponse, error)
+	GetLoanAccountInfo() (*V5GetLoanAccountInfoResponse, error)
 }
 
 // V5SpotMarginTradeService :
 type V5SpotMarginTradeService struct {
 	client *Client
 }
+
+// V5GetVIPMarginDataParam :
+type V5GetVIPMarginDataParam struct {
+	VipLevel *string     `url:"vipLevel,omitempty"` // e.g. "No VIP", "VIP-1"
+	Currency *bybit.Coin `url:"currency,omitempty"`
+}
+
+// V5GetVIPMarginDataResponse :
+type V5GetVIPMarginDataResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetVIPMarginDataResult `json:"result"`
+}
+
+// V5GetVIPMarginDataResult :
+type V5GetVIPMarginDataResult struct {
+	VipCoinList []struct {
+		VipLevel string                   `json:"vipLevel"`
+		List     []V5GetVIPMarginDataItem `json:"list"`
+	} `json:"vipCoinList"`
+}
+
+// V5GetVIPMarginDataItem :
+type V5GetVIPMarginDataItem struct {
+	Currency           bybit.Coin    `json:"currency"`
+	Borrowable         bool          `json:"borrowable"`
+	CollateralRatio    bybit.Decimal `json:"collateralRatio"`
+	HourlyBorrowRate   bybit.Decimal `json:"hourlyBorrowRate"`
+	LiquidationOrder   int           `json:"liquidationOrder"`
+	MarginCollateral   bool          `json:"marginCollateral"`
+	MaxBorrowingAmount bybit.Decimal `json:"maxBorrowingAmount"`
+}
+
+// GetVIPMarginData :
+func (s *V5SpotMarginTradeService) GetVIPMarginData(param V5GetVIPMarginDataParam) (*V5GetVIPMarginDataResponse, error) {
+	var res V5GetVIPMarginDataResponse
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5SpotMarginTrade.GetVIPMarginData").getPublicly("/v5/spot-margin-trade/data", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5ToggleMarginTradeParam :
+type V5ToggleMarginTradeParam struct {
+	SpotMarginMode string `json:"spotMarginMode"` // "1": on, "0": off
+}
+
+// V5ToggleMarginTradeResponse :
+type V5ToggleMarginTradeResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5ToggleMarginTradeResult `json:"result"`
+}
+
+// V5ToggleMarginTradeResult :
+type V5ToggleMarginTradeResult struct {
+	SpotMarginMode string `json:"spotMarginMode"`
+}
+
+// ToggleMarginTrade :
+func (s *V5SpotMarginTradeService) ToggleMarginTrade(param V5ToggleMarginTradeParam) (*V5ToggleMarginTradeResponse, error) {
+	var res V5ToggleMarginTradeResponse
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5SpotMarginTrade.ToggleMarginTrade").postV5JSON("/v5/spot-margin-trade/switch-mode", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5SpotMarginSetLeverageParam :
+type V5SpotMarginSetLeverageParam struct {
+	Leverage bybit.Decimal `json:"leverage"` // [2, 10]
+}
+
+// V5SpotMarginSetLeverageResponse :
+type V5SpotMarginSetLeverageResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           interface{} `json:"result"`
+}
+
+// SetLeverage :
+func (s *V5SpotMarginTradeService) SetLeverage(param V5SpotMarginSetLeverageParam) (*V5SpotMarginSetLeverageResponse, error) {
+	var res V5SpotMarginSetLeverageResponse
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5SpotMarginTrade.SetLeverage").postV5JSON("/v5/spot-margin-trade/set-leverage", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5GetStatusAndLeverageResponse :
+type V5GetStatusAndLeverageResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetStatusAndLeverageResult `json:"result"`
+}
+
+// V5GetStatusAndLeverageResult :
+type V5GetStatusAndLeverageResult struct {
+	SpotLeverage      bybit.Decimal `json:"spotLeverage"`
+	SpotMarginMode    string        `json:"spotMarginMode"`
+	EffectiveLeverage bybit.Decimal `json:"effectiveLeverage"`
+}
+
+// GetStatusAndLeverage :
+func (s *V5SpotMarginTradeService) GetStatusAndLeverage() (*V5GetStatusAndLeverageResponse, error) {
+	var res V5GetStatusAndLeverageResponse
+
+	if err := s.client.withEndpoint("V5SpotMarginTrade.GetStatusAndLeverage").getV5Privately("/v5/spot-margin-trade/state", url.Values{}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5ToggleMarginTradeNormalParam :
+type V5ToggleMarginTradeNormalParam struct {
+	Switch int `json:"switch"` // 1: on, 0: off
+}
+
+// V5ToggleMarginTradeNormalResponse :
+type V5ToggleMarginTradeNormalResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5ToggleMarginTradeNormalResult `json:"result"`
+}
+
+// V5ToggleMarginTradeNormalResult :
+type V5ToggleMarginTradeNormalResult struct {
+	SwitchStatus int `json:"switchStatus"`
+}
+
+// ToggleMarginTradeNormal :
+func (s *V5SpotMarginTradeService) ToggleMarginTradeNormal(param V5ToggleMarginTradeNormalParam) (*V5ToggleMarginTradeNormalResponse, error) {
+	var res V5ToggleMarginTradeNormalResponse
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5SpotMarginTrade.ToggleMarginTradeNormal").postV5JSON("/v5/spot-cross-margin-trade/switch", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5GetBorrowableCoinInfoParam :
+type V5GetBorrowableCoinInfoParam struct {
+	Coin *bybit.Coin `url:"coin,omitempty"`
+}
+
+// V5GetBorrowableCoinInfoResponse :
+type V5GetBorrowableCoinInfoResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetBorrowableCoinInfoResult `json:"result"`
+}
+
+// V5GetBorrowableCoinInfoResult :
+type V5GetBorrowableCoinInfoResult struct {
+	List []V5GetBorrowableCoinInfoItem `json:"list"`
+}
+
+// V5GetBorrowableCoinInfoItem :
+type V5GetBorrowableCoinInfoItem struct {
+	Coin               bybit.Coin `json:"coin"`
+	BorrowingPrecision int        `json:"borrowingPrecision"`
+	RepaymentPrecision int        `json:"repaymentPrecision"`
+}
+
+// GetBorrowableCoinInfo :
+func (s *V5SpotMarginTradeService) GetBorrowableCoinInfo(param V5GetBorrowableCoinInfoParam) (*V5GetBorrowableCoinInfoResponse, error) {
+	var res V5GetBorrowableCoinInfoResponse
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5SpotMarginTrade.GetBorrowableCoinInfo").getPublicly("/v5/spot-cross-margin-trade/borrow-token", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5GetInterestQuotaParam :
+type V5GetInterestQuotaParam struct {
+	Coin bybit.Coin `url:"coin"`
+}
+
+// V5GetInterestQuotaResponse :
+type V5GetInterestQuotaResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetInterestQuotaResult `json:"result"`
+}
+
+// V5GetInterestQuotaResult :
+type V5GetInterestQuotaResult struct {
+	Coin           bybit.Coin    `json:"coin"`
+	InterestRate   bybit.Decimal `json:"interestRate"`
+	LoanAbleAmount bybit.Decimal `json:"loanAbleAmount"`
+	MaxLoanAmount  bybit.Decimal `json:"maxLoanAmount"`
+}
+
+// GetInterestQuota :
+func (s *V5SpotMarginTradeService) GetInterestQuota(param V5GetInterestQuotaParam) (*V5GetInterestQuotaResponse, error) {
+	var res V5GetInterestQuotaResponse
+
+	if param.Coin == "" {
+		return nil, fmt.Errorf("Coin needed")
+	}
+
+	queryString, err := query.Values(param)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.withEndpoint("V5SpotMarginTrade.GetInterestQuota").getV5Privately("/v5/spot-cross-margin-trade/loan-info", queryString, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5GetLoanAccountInfoResponse :
+type V5GetLoanAccountInfoResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetLoanAccountInfoResult `json:"result"`
+}
+
+// V5GetLoanAccountInfoResult :
+type V5GetLoanAccountInfoResult struct {
+	AcctBalanceSum  bybit.Decimal `json:"acctBalanceSum"`
+	DebtBalanceSum  bybit.Decimal `json:"debtBalanceSum"`
+	RiskRate        bybit.Decimal `json:"riskRate"`
+	Status          int           `json:"status"` // 1: normal, 2: AML, 3: margin call, 4: liquidating
+	SwitchStatus    int           `json:"switchStatus"`
+	LoanAccountList []struct {
+		TokenID      bybit.Coin    `json:"tokenId"`
+		Free         bybit.Decimal `json:"free"`
+		Locked       bybit.Decimal `json:"locked"`
+		Loan         bybit.Decimal `json:"loan"`
+		Interest     bybit.Decimal `json:"interest"`
+		RemainAmount bybit.Decimal `json:"remainAmount"`
+		Total        bybit.Decimal `json:"total"`
+	} `json:"loanAccountList"`
+}
+
+// GetLoanAccountInfo :
+func (s *V5SpotMarginTradeService) GetLoanAccountInfo() (*V5GetLoanAccountInfoResponse, error) {
+	var res V5GetLoanAccountInfoResponse
+
+	if err := s.client.withEndpoint("V5SpotMarginTrade.GetLoanAccountInfo").getV5Privately("/v5/spot-cross-margin-trade/account", url.Values{}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
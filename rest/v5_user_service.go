@@ -1,6 +1,8 @@
 package rest
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/url"
 	"time"
 )
@@ -8,6 +10,14 @@ import (
 // V5UserServiceI :
 type V5UserServiceI interface {
 	GetAPIKey() (*V5APIKeyResponse, error)
+	CreateSubMember(V5CreateSubMemberParam) (*V5CreateSubMemberResponse, error)
+	GetSubUIDList() (*V5GetSubUIDListResponse, error)
+	FreezeSubMember(V5FreezeSubMemberParam) (*V5FreezeSubMemberResponse, error)
+	CreateSubAPIKey(V5CreateSubAPIKeyParam) (*V5CreateSubAPIKeyResponse, error)
+	ModifyMasterAPIKey(V5ModifyMasterAPIKeyParam) (*V5ModifyAPIKeyResponse, error)
+	ModifySubAPIKey(V5ModifySubAPIKeyParam) (*V5ModifyAPIKeyResponse, error)
+	DeleteMasterAPIKey() (*V5DeleteAPIKeyResponse, error)
+	DeleteSubAPIKey(V5DeleteSubAPIKeyParam) (*V5DeleteAPIKeyResponse, error)
 }
 
 // V5UserService :
@@ -15,6 +25,20 @@ type V5UserService struct {
 	client *Client
 }
 
+// V5ApiKeyPermissions :
+// When used as a param, omitted permission groups are left unchanged or empty.
+type V5ApiKeyPermissions struct {
+	ContractTrade []string `json:"ContractTrade,omitempty"`
+	Spot          []string `json:"Spot,omitempty"`
+	Wallet        []string `json:"Wallet,omitempty"`
+	Options       []string `json:"Options,omitempty"`
+	Derivatives   []string `json:"Derivatives,omitempty"`
+	CopyTrading   []string `json:"CopyTrading,omitempty"`
+	BlockTrade    []string `json:"BlockTrade,omitempty"`
+	Exchange      []string `json:"Exchange,omitempty"`
+	Nft           []string `json:"NFT,omitempty"`
+}
+
 // V5APIKeyResponse :
 type V5APIKeyResponse struct {
 	CommonV5Response `json:",inline"`
@@ -23,34 +47,24 @@ type V5APIKeyResponse struct {
 
 // V5ApiKeyResult :
 type V5ApiKeyResult struct {
-	ID          string `json:"id"`
-	Note        string `json:"note"`
-	APIKey      string `json:"apiKey"`
-	ReadOnly    int    `json:"readOnly"`
-	Secret      string `json:"secret"`
-	Permissions struct {
-		ContractTrade []string `json:"ContractTrade"`
-		Spot          []string `json:"Spot"`
-		Wallet        []string `json:"Wallet"`
-		Options       []string `json:"Options"`
-		Derivatives   []string `json:"Derivatives"`
-		CopyTrading   []string `json:"CopyTrading"`
-		BlockTrade    []string `json:"BlockTrade"`
-		Exchange      []string `json:"Exchange"`
-		Nft           []string `json:"NFT"`
-	} `json:"permissions"`
-	Ips           []string  `json:"ips"`
-	Type          int       `json:"type"`
-	DeadlineDay   int       `json:"deadlineDay"`
-	ExpiredAt     time.Time `json:"expiredAt"`
-	CreatedAt     time.Time `json:"createdAt"`
-	Unified       int       `json:"unified"`
-	Uta           int       `json:"uta"`
-	UserID        int       `json:"userID"`
-	InviterID     int       `json:"inviterID"`
-	VipLevel      string    `json:"vipLevel"`
-	MktMakerLevel string    `json:"mktMakerLevel"`
-	AffiliateID   int       `json:"affiliateID"`
+	ID            string              `json:"id"`
+	Note          string              `json:"note"`
+	APIKey        string              `json:"apiKey"`
+	ReadOnly      int                 `json:"readOnly"`
+	Secret        string              `json:"secret"`
+	Permissions   V5ApiKeyPermissions `json:"permissions"`
+	Ips           []string            `json:"ips"`
+	Type          int                 `json:"type"`
+	DeadlineDay   int                 `json:"deadlineDay"`
+	ExpiredAt     time.Time           `json:"expiredAt"`
+	CreatedAt     time.Time           `json:"createdAt"`
+	Unified       int                 `json:"unified"`
+	Uta           int                 `json:"uta"`
+	UserID        int                 `json:"userID"`
+	InviterID     int                 `json:"inviterID"`
+	VipLevel      string              `json:"vipLevel"`
+	MktMakerLevel string              `json:"mktMakerLevel"`
+	AffiliateID   int                 `json:"affiliateID"`
 }
 
 // GetAPIKey :
@@ -59,9 +73,244 @@ func (s *V5UserService) GetAPIKey() (*V5APIKeyResponse, error) {
 		res V5APIKeyResponse
 	)
 
-	if err := s.client.getV5Privately("/v5/user/query-api", url.Values{}, &res); err != nil {
+	if err := s.client.withEndpoint("V5User.GetAPIKey").getV5Privately("/v5/user/query-api", url.Values{}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// V5SubMember :
+type V5SubMember struct {
+	UID        string `json:"uid"`
+	Username   string `json:"username"`
+	MemberType int    `json:"memberType"`
+	Status     int    `json:"status"` // 1: normal, 2: login banned, 4: frozen
+	Remark     string `json:"remark"`
+}
+
+// V5CreateSubMemberParam :
+type V5CreateSubMemberParam struct {
+	Username   string `json:"username"`   // 6-16 characters, must include both numbers and letters
+	MemberType int    `json:"memberType"` // 1: normal sub account, 6: custodial sub account
+
+	Switch *int    `json:"switch,omitempty"` // 0: turn off quick login (default), 1: turn on quick login
+	IsUta  *bool   `json:"isUta,omitempty"`
+	Note   *string `json:"note,omitempty"`
+}
+
+// V5CreateSubMemberResponse :
+type V5CreateSubMemberResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5SubMember `json:"result"`
+}
+
+// CreateSubMember :
+func (s *V5UserService) CreateSubMember(param V5CreateSubMemberParam) (*V5CreateSubMemberResponse, error) {
+	var res V5CreateSubMemberResponse
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5User.CreateSubMember").postV5JSON("/v5/user/create-sub-member", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5GetSubUIDListResponse :
+type V5GetSubUIDListResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5GetSubUIDListResult `json:"result"`
+}
+
+// V5GetSubUIDListResult :
+type V5GetSubUIDListResult struct {
+	SubMembers []V5SubMember `json:"subMembers"`
+}
+
+// GetSubUIDList :
+func (s *V5UserService) GetSubUIDList() (*V5GetSubUIDListResponse, error) {
+	var res V5GetSubUIDListResponse
+
+	if err := s.client.withEndpoint("V5User.GetSubUIDList").getV5Privately("/v5/user/query-sub-members", url.Values{}, &res); err != nil {
 		return nil, err
 	}
 
 	return &res, nil
 }
+
+// V5FreezeSubMemberParam :
+type V5FreezeSubMemberParam struct {
+	SubUID int `json:"subuid"`
+	Frozen int `json:"frozen"` // 0: unfreeze, 1: freeze
+}
+
+// V5FreezeSubMemberResponse :
+type V5FreezeSubMemberResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           interface{} `json:"result"`
+}
+
+// FreezeSubMember :
+func (s *V5UserService) FreezeSubMember(param V5FreezeSubMemberParam) (*V5FreezeSubMemberResponse, error) {
+	var res V5FreezeSubMemberResponse
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5User.FreezeSubMember").postV5JSON("/v5/user/frozen-sub-member", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5CreateSubAPIKeyParam :
+type V5CreateSubAPIKeyParam struct {
+	SubUID      int                 `json:"subuid"`
+	ReadOnly    int                 `json:"readOnly"` // 0: read and write, 1: read only
+	Permissions V5ApiKeyPermissions `json:"permissions"`
+
+	Note *string `json:"note,omitempty"`
+	IPs  *string `json:"ips,omitempty"` // Comma separated. If not passed, the key is valid for 90 days
+}
+
+// V5CreateSubAPIKeyResponse :
+type V5CreateSubAPIKeyResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5CreateSubAPIKeyResult `json:"result"`
+}
+
+// V5CreateSubAPIKeyResult :
+type V5CreateSubAPIKeyResult struct {
+	ID          string              `json:"id"`
+	Note        string              `json:"note"`
+	APIKey      string              `json:"apiKey"`
+	ReadOnly    int                 `json:"readOnly"`
+	Secret      string              `json:"secret"`
+	Permissions V5ApiKeyPermissions `json:"permissions"`
+}
+
+// CreateSubAPIKey :
+func (s *V5UserService) CreateSubAPIKey(param V5CreateSubAPIKeyParam) (*V5CreateSubAPIKeyResponse, error) {
+	var res V5CreateSubAPIKeyResponse
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5User.CreateSubAPIKey").postV5JSON("/v5/user/create-sub-api", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5ModifyMasterAPIKeyParam :
+type V5ModifyMasterAPIKeyParam struct {
+	ReadOnly    *int                 `json:"readOnly,omitempty"` // 0: read and write, 1: read only
+	IPs         *string              `json:"ips,omitempty"`      // Comma separated. "*" means no binding
+	Permissions *V5ApiKeyPermissions `json:"permissions,omitempty"`
+}
+
+// V5ModifySubAPIKeyParam :
+type V5ModifySubAPIKeyParam struct {
+	APIKey      *string              `json:"apikey,omitempty"`   // If not passed, the key used to sign the request is modified
+	ReadOnly    *int                 `json:"readOnly,omitempty"` // 0: read and write, 1: read only
+	IPs         *string              `json:"ips,omitempty"`      // Comma separated. "*" means no binding
+	Permissions *V5ApiKeyPermissions `json:"permissions,omitempty"`
+}
+
+// V5ModifyAPIKeyResponse :
+type V5ModifyAPIKeyResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           V5ModifyAPIKeyResult `json:"result"`
+}
+
+// V5ModifyAPIKeyResult :
+type V5ModifyAPIKeyResult struct {
+	ID          string              `json:"id"`
+	Note        string              `json:"note"`
+	APIKey      string              `json:"apiKey"`
+	ReadOnly    int                 `json:"readOnly"`
+	Secret      string              `json:"secret"`
+	Permissions V5ApiKeyPermissions `json:"permissions"`
+	IPs         []string            `json:"ips"`
+}
+
+// ModifyMasterAPIKey :
+func (s *V5UserService) ModifyMasterAPIKey(param V5ModifyMasterAPIKeyParam) (*V5ModifyAPIKeyResponse, error) {
+	var res V5ModifyAPIKeyResponse
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5User.ModifyMasterAPIKey").postV5JSON("/v5/user/update-api", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// ModifySubAPIKey :
+func (s *V5UserService) ModifySubAPIKey(param V5ModifySubAPIKeyParam) (*V5ModifyAPIKeyResponse, error) {
+	var res V5ModifyAPIKeyResponse
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5User.ModifySubAPIKey").postV5JSON("/v5/user/update-sub-api", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// V5DeleteSubAPIKeyParam :
+type V5DeleteSubAPIKeyParam struct {
+	APIKey *string `json:"apikey,omitempty"` // If not passed, the key used to sign the request is deleted
+}
+
+// V5DeleteAPIKeyResponse :
+type V5DeleteAPIKeyResponse struct {
+	CommonV5Response `json:",inline"`
+	Result           interface{} `json:"result"`
+}
+
+// DeleteMasterAPIKey : the key used to sign the request is deleted
+func (s *V5UserService) DeleteMasterAPIKey() (*V5DeleteAPIKeyResponse, error) {
+	var res V5DeleteAPIKeyResponse
+
+	if err := s.client.withEndpoint("V5User.DeleteMasterAPIKey").postV5JSON("/v5/user/delete-api", []byte("{}"), &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// DeleteSubAPIKey :
+func (s *V5UserService) DeleteSubAPIKey(param V5DeleteSubAPIKeyParam) (*V5DeleteAPIKeyResponse, error) {
+	var res V5DeleteAPIKeyResponse
+
+	body, err := json.Marshal(param)
+	if err != nil {
+		return &res, fmt.Errorf("json marshal: %w", err)
+	}
+
+	if err := s.client.withEndpoint("V5User.DeleteSubAPIKey").postV5JSON("/v5/user/delete-sub-api", body, &res); err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
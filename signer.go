@@ -0,0 +1,81 @@
+package bybit
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+)
+
+// Signer : signs the payload of a private request, so that the secret never has to live in the client.
+// Implement it to delegate signing to e.g. an HSM or a local signing daemon
+type Signer interface {
+	Sign(payload []byte) (string, error)
+}
+
+// HMACSigner : for system-generated API keys
+type HMACSigner struct {
+	secret []byte
+}
+
+// NewHMACSigner :
+func NewHMACSigner(secret string) *HMACSigner {
+	return &HMACSigner{secret: []byte(secret)}
+}
+
+// Sign : hex encoded HMAC-SHA256
+func (s *HMACSigner) Sign(payload []byte) (string, error) {
+	h := hmac.New(sha256.New, s.secret)
+	if _, err := h.Write(payload); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// RSASigner : for self-generated API keys, whose public key is registered on Bybit
+type RSASigner struct {
+	privateKey *rsa.PrivateKey
+}
+
+// NewRSASigner :
+func NewRSASigner(privateKey *rsa.PrivateKey) *RSASigner {
+	return &RSASigner{privateKey: privateKey}
+}
+
+// NewRSASignerFromPEM : accepts PKCS#1 and PKCS#8 private keys
+func NewRSASignerFromPEM(pemBytes []byte) (*RSASigner, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	if privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return NewRSASigner(privateKey), nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("not an RSA private key")
+	}
+	return NewRSASigner(privateKey), nil
+}
+
+// Sign : base64 encoded RSA-SHA256 (PKCS #1 v1.5)
+func (s *RSASigner) Sign(payload []byte) (string, error) {
+	hashed := sha256.Sum256(payload)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.privateKey, crypto.SHA256, hashed[:])
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(signature), nil
+}
@@ -2,9 +2,6 @@ package ws
 
 import (
 	"context"
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -13,6 +10,7 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/sngyai/go-bybit"
 )
 
 const (
@@ -30,7 +28,15 @@ type WebSocketClient struct {
 	Dialer  *websocket.Dialer
 
 	key    string
-	secret string
+	signer bybit.Signer
+	clock  Clock
+
+	observers []Observer
+}
+
+// Clock : source of the time used to sign the auth request. rest.ClockSync satisfies it
+type Clock interface {
+	Now() time.Time
 }
 
 // NewWebsocketClient :
@@ -41,10 +47,15 @@ func NewWebsocketClient() *WebSocketClient {
 	}
 }
 
-// WithAuth :
+// WithAuth : signs with HMAC-SHA256 of the secret
 func (c *WebSocketClient) WithAuth(key string, secret string) *WebSocketClient {
+	return c.WithSigner(key, bybit.NewHMACSigner(secret))
+}
+
+// WithSigner : signs with signer, e.g. bybit.RSASigner for self-generated API keys
+func (c *WebSocketClient) WithSigner(key string, signer bybit.Signer) *WebSocketClient {
 	c.key = key
-	c.secret = secret
+	c.signer = signer
 
 	return c
 }
@@ -63,23 +74,33 @@ func (c *WebSocketClient) WithDialer(dialer *websocket.Dialer) *WebSocketClient
 	return c
 }
 
-// hasAuth : check has auth key and secret
+// WithClock : signs the auth request with a server-corrected clock
+func (c *WebSocketClient) WithClock(clock Clock) *WebSocketClient {
+	c.clock = clock
+
+	return c
+}
+
+// hasAuth : check has auth key and signer
 func (c *WebSocketClient) hasAuth() bool {
-	return c.key != "" && c.secret != ""
+	return c.key != "" && c.signer != nil
 }
 
 func (c *WebSocketClient) BuildAuthParam() ([]byte, error) {
 	if !c.hasAuth() {
-		return nil, fmt.Errorf("this is private endpoint, please set api key and secret")
+		return nil, fmt.Errorf("this is private endpoint, please set api key and secret or signer")
 	}
 
-	expires := time.Now().Unix()*1000 + 10000
+	now := time.Now()
+	if c.clock != nil {
+		now = c.clock.Now()
+	}
+	expires := now.UnixNano()/int64(time.Millisecond) + 10000
 	req := fmt.Sprintf("GET/realtime%d", expires)
-	s := hmac.New(sha256.New, []byte(c.secret))
-	if _, err := s.Write([]byte(req)); err != nil {
+	signature, err := c.signer.Sign([]byte(req))
+	if err != nil {
 		return nil, err
 	}
-	signature := hex.EncodeToString(s.Sum(nil))
 	param := struct {
 		Op   string        `json:"op"`
 		Args []interface{} `json:"args"`
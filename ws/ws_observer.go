@@ -0,0 +1,76 @@
+package ws
+
+import (
+	"context"
+	"time"
+)
+
+// Observer : receives events of the websocket services, e.g. for tracing and metrics. Any of the funcs may be nil
+type Observer struct {
+	// SessionStarted : called when a service starts serving its connection.
+	// The returned func, if any, is called with the error that ended the session, nil when closed normally
+	SessionStarted func(ctx context.Context, path string) func(err error)
+
+	// Reconnected : called once a service has reconnected its connection
+	Reconnected func(path string)
+
+	// MessageHandled : called after the handler of a subscription returned
+	MessageHandled func(path string, topic string, latency time.Duration, err error)
+
+	// MessageDropped : called for a message no handler was called for
+	MessageDropped func(path string, topic string, reason string)
+}
+
+// WithObserver : appends o to the observers. Observers are called in the order they were added
+func (c *WebSocketClient) WithObserver(o Observer) *WebSocketClient {
+	observers := make([]Observer, 0, len(c.observers)+1)
+	observers = append(observers, c.observers...)
+	c.observers = append(observers, o)
+
+	return c
+}
+
+// ObserveSessionStarted : for the services, returns the func to call once the session ends
+func (c *WebSocketClient) ObserveSessionStarted(ctx context.Context, path string) func(err error) {
+	var ends []func(error)
+	for _, o := range c.observers {
+		if o.SessionStarted == nil {
+			continue
+		}
+		if end := o.SessionStarted(ctx, path); end != nil {
+			ends = append(ends, end)
+		}
+	}
+	return func(err error) {
+		for _, end := range ends {
+			end(err)
+		}
+	}
+}
+
+// ObserveReconnected : for the services
+func (c *WebSocketClient) ObserveReconnected(path string) {
+	for _, o := range c.observers {
+		if o.Reconnected != nil {
+			o.Reconnected(path)
+		}
+	}
+}
+
+// ObserveMessageHandled : for the services
+func (c *WebSocketClient) ObserveMessageHandled(path string, topic string, latency time.Duration, err error) {
+	for _, o := range c.observers {
+		if o.MessageHandled != nil {
+			o.MessageHandled(path, topic, latency, err)
+		}
+	}
+}
+
+// ObserveMessageDropped : for the services
+func (c *WebSocketClient) ObserveMessageDropped(path string, topic string, reason string) {
+	for _, o := range c.observers {
+		if o.MessageDropped != nil {
+			o.MessageDropped(path, topic, reason)
+		}
+	}
+}
@@ -0,0 +1,415 @@
+package wsv5
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/shopspring/decimal"
+	"github.com/sngyai/go-bybit"
+	"github.com/sngyai/go-bybit/rest"
+)
+
+// ErrOrderBookGap : a delta does not follow the last update, and the book has no way to resync
+var ErrOrderBookGap = errors.New("orderbook update gap")
+
+// OrderBookLevel :
+type OrderBookLevel struct {
+	Price bybit.Decimal
+	Size  bybit.Decimal
+}
+
+// OrderBookSnapshotFunc : fetches a full book to resync from, e.g. RESTOrderBookSnapshot
+type OrderBookSnapshotFunc func() (*PublicOrderBookData, error)
+
+// RESTOrderBookSnapshot : resyncs from the REST V5 orderbook endpoint, limit levels per side.
+// Deltas up to the update ID it returns are skipped, so the book should use the depth whose stream
+// shares the update IDs of the endpoint, as documented by Bybit for each category
+func RESTOrderBookSnapshot(market rest.V5MarketServiceI, category bybit.CategoryV5, symbol bybit.SymbolV5, limit int) OrderBookSnapshotFunc {
+	return func() (*PublicOrderBookData, error) {
+		res, err := market.GetOrderbook(rest.V5GetOrderbookParam{
+			Category: category,
+			Symbol:   symbol,
+			Limit:    &limit,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		data := &PublicOrderBookData{
+			Symbol:   res.Result.Symbol,
+			Bids:     make(PublicOrderBookBids, len(res.Result.Bids)),
+			Asks:     make(PublicOrderBookAsks, len(res.Result.Asks)),
+			UpdateID: int(res.Result.UpdateID),
+		}
+		for i, level := range res.Result.Bids {
+			data.Bids[i].Price = level.Price
+			data.Bids[i].Size = level.Size
+		}
+		for i, level := range res.Result.Asks {
+			data.Asks[i].Price = level.Price
+			data.Asks[i].Size = level.Size
+		}
+		return data, nil
+	}
+}
+
+// OrderBookResubscriber : subscribes again to an orderbook topic for a fresh snapshot. *PublicService satisfies it
+type OrderBookResubscriber interface {
+	ResubscribeOrderBook(PublicOrderBookParamKey) error
+}
+
+// maxPendingDeltas : deltas buffered while the snapshot func runs. Beyond it they are dropped,
+// so the replay finds a gap and resyncs again
+const maxPendingDeltas = 1000
+
+// OrderBook : a local order book of one symbol, maintained from the snapshots and deltas of its stream.
+//
+//	book := wsv5.NewOrderBook(wsv5.PublicOrderBookParamKey{Depth: 50, Symbol: bybit.SymbolV5BTCUSDT})
+//	unsubscribe, err := book.Subscribe(svc)
+//
+// A delta whose update ID does not follow the last one, or whose seq goes backwards, makes the book
+// resync. With a snapshot func, it runs apart from the read loop, the deltas received meanwhile being
+// replayed on top of its snapshot. Without one, or when it fails, the book subscribes again for a fresh
+// snapshot. Until then, the book reports it is not synced and keeps its last levels. The queries are safe
+// for concurrent use
+type OrderBook struct {
+	key      PublicOrderBookParamKey
+	snapshot OrderBookSnapshotFunc
+
+	mu           sync.RWMutex
+	resubscriber OrderBookResubscriber
+	bids         []OrderBookLevel // best, i.e. highest, first
+	asks         []OrderBookLevel // best, i.e. lowest, first
+	updateID     int
+	seq          int
+	ts           int64
+	synced       bool
+	// afterResync : the book was loaded from the snapshot func, whose update ID the next deltas may not exceed
+	afterResync bool
+	// resyncing : waiting for the snapshot func or a fresh snapshot of the stream
+	resyncing bool
+	pending   []PublicOrderBookResponse
+	// generation : bumped on every resync and snapshot, so that a snapshot func overtaken by them is discarded
+	generation int
+}
+
+// NewOrderBook :
+func NewOrderBook(key PublicOrderBookParamKey) *OrderBook {
+	return &OrderBook{key: key}
+}
+
+// WithSnapshotFunc : resyncs from f rather than by subscribing again
+func (b *OrderBook) WithSnapshotFunc(f OrderBookSnapshotFunc) *OrderBook {
+	b.snapshot = f
+
+	return b
+}
+
+// Subscribe : maintains the book from the orderbook stream of svc
+func (b *OrderBook) Subscribe(svc PublicServiceI) (func() error, error) {
+	b.mu.Lock()
+	b.resubscriber, _ = svc.(OrderBookResubscriber)
+	b.mu.Unlock()
+
+	return svc.SubscribeOrderBook(b.key, b.Apply)
+}
+
+// Apply : applies a message of the orderbook stream, for callers handling the subscription themselves
+func (b *OrderBook) Apply(resp PublicOrderBookResponse) error {
+	if key := resp.Key(); key != b.key {
+		return fmt.Errorf("orderbook %s applied to the book of %s", resp.Topic, b.key.Topic())
+	}
+
+	b.mu.Lock()
+	resubscribe, err := b.applyMessageLocked(resp)
+	b.mu.Unlock()
+
+	if resubscribe == nil {
+		return err
+	}
+	return resubscribe()
+}
+
+// applyMessageLocked : returns the resubscription the message calls for, to run once b.mu is released
+func (b *OrderBook) applyMessageLocked(resp PublicOrderBookResponse) (func() error, error) {
+	switch resp.Type {
+	case "snapshot":
+		b.loadLocked(&resp.Data)
+		b.ts = resp.TimeStamp
+		b.afterResync = false
+		b.resyncing = false
+		b.pending = nil
+		b.generation++
+		return nil, nil
+	case "delta":
+	default:
+		return nil, fmt.Errorf("unknown orderbook message type: %s", resp.Type)
+	}
+
+	switch {
+	case b.resyncing:
+		if len(b.pending) < maxPendingDeltas {
+			b.pending = append(b.pending, resp)
+		}
+		return nil, nil
+	case !b.synced:
+		// no snapshot yet, or the last resync failed
+		return b.resyncLocked()
+	case !b.applyLocked(&resp):
+		return b.resyncLocked()
+	}
+	return nil, nil
+}
+
+// applyLocked : applies a delta, false on a gap
+func (b *OrderBook) applyLocked(resp *PublicOrderBookResponse) bool {
+	data := &resp.Data
+	if b.afterResync && data.UpdateID <= b.updateID {
+		// already in the snapshot
+		return true
+	}
+	if data.UpdateID != b.updateID+1 || data.Seq < b.seq {
+		return false
+	}
+
+	for _, level := range data.Bids {
+		b.bids = setLevel(b.bids, level.Price, level.Size, true)
+	}
+	for _, level := range data.Asks {
+		b.asks = setLevel(b.asks, level.Price, level.Size, false)
+	}
+	b.updateID = data.UpdateID
+	b.seq = data.Seq
+	b.ts = resp.TimeStamp
+	b.afterResync = false
+	return true
+}
+
+// resyncLocked : unsyncs the book, and starts reloading it.
+// Returns the resubscription to run once b.mu is released, when there is no snapshot func
+func (b *OrderBook) resyncLocked() (func() error, error) {
+	b.synced = false
+	b.resyncing = true
+	b.pending = nil
+	b.generation++
+
+	if b.snapshot != nil {
+		go b.loadSnapshot(b.generation)
+		return nil, nil
+	}
+	return b.resubscribeLocked()
+}
+
+// resubscribeLocked : returns the request of a fresh snapshot from the stream. It writes to the websocket,
+// so is to be run once b.mu is released
+func (b *OrderBook) resubscribeLocked() (func() error, error) {
+	if b.resubscriber == nil {
+		b.resyncing = false
+		return nil, ErrOrderBookGap
+	}
+
+	resubscriber, generation := b.resubscriber, b.generation
+	return func() error {
+		if err := resubscriber.ResubscribeOrderBook(b.key); err != nil {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			if generation == b.generation {
+				b.resyncing = false
+			}
+			return err
+		}
+		return nil
+	}, nil
+}
+
+// loadSnapshot : loads the book from the snapshot func, then replays the deltas received meanwhile.
+// A failure leaves the book unsynced, the next delta retrying
+func (b *OrderBook) loadSnapshot(generation int) {
+	data, err := b.snapshot()
+
+	b.mu.Lock()
+	resubscribe := b.loadSnapshotLocked(generation, data, err)
+	b.mu.Unlock()
+
+	if resubscribe != nil {
+		_ = resubscribe()
+	}
+}
+
+// loadSnapshotLocked : returns the resubscription to run once b.mu is released, when the snapshot func failed
+func (b *OrderBook) loadSnapshotLocked(generation int, data *PublicOrderBookData, err error) func() error {
+	if generation != b.generation {
+		return nil
+	}
+	if err != nil {
+		resubscribe, _ := b.resubscribeLocked()
+		return resubscribe
+	}
+
+	b.loadLocked(data)
+	b.afterResync = true
+	b.resyncing = false
+	pending := b.pending
+	b.pending = nil
+	for i := range pending {
+		if !b.applyLocked(&pending[i]) {
+			resubscribe, _ := b.resyncLocked()
+			return resubscribe
+		}
+	}
+	return nil
+}
+
+// loadLocked : replaces the levels with those of a snapshot
+func (b *OrderBook) loadLocked(data *PublicOrderBookData) {
+	b.bids = make([]OrderBookLevel, 0, len(data.Bids))
+	for _, level := range data.Bids {
+		b.bids = setLevel(b.bids, level.Price, level.Size, true)
+	}
+	b.asks = make([]OrderBookLevel, 0, len(data.Asks))
+	for _, level := range data.Asks {
+		b.asks = setLevel(b.asks, level.Price, level.Size, false)
+	}
+	b.updateID = data.UpdateID
+	b.seq = data.Seq
+	b.synced = true
+}
+
+// setLevel : a zero size deletes the level. levels stay sorted best first
+func setLevel(levels []OrderBookLevel, price bybit.Decimal, size bybit.Decimal, descending bool) []OrderBookLevel {
+	i, found := searchLevel(levels, price.Decimal(), descending)
+	switch {
+	case size.Decimal().IsZero() && found:
+		return append(levels[:i], levels[i+1:]...)
+	case size.Decimal().IsZero():
+		return levels
+	case found:
+		levels[i].Size = size
+		return levels
+	}
+	levels = append(levels, OrderBookLevel{})
+	copy(levels[i+1:], levels[i:])
+	levels[i] = OrderBookLevel{Price: price, Size: size}
+	return levels
+}
+
+// searchLevel : index of price in levels, or where it would be inserted
+func searchLevel(levels []OrderBookLevel, price decimal.Decimal, descending bool) (int, bool) {
+	i := sort.Search(len(levels), func(i int) bool {
+		if descending {
+			return levels[i].Price.Decimal().LessThanOrEqual(price)
+		}
+		return levels[i].Price.Decimal().GreaterThanOrEqual(price)
+	})
+	return i, i < len(levels) && levels[i].Price.Decimal().Equal(price)
+}
+
+// Synced : whether the book follows the stream, false before the first snapshot and while resyncing
+func (b *OrderBook) Synced() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.synced
+}
+
+// UpdateID : of the last applied message
+func (b *OrderBook) UpdateID() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.updateID
+}
+
+// TimeStamp : of the last applied message, in milliseconds
+func (b *OrderBook) TimeStamp() int64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.ts
+}
+
+// BestBid : false when there is no bid
+func (b *OrderBook) BestBid() (OrderBookLevel, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if len(b.bids) == 0 {
+		return OrderBookLevel{}, false
+	}
+	return b.bids[0], true
+}
+
+// BestAsk : false when there is no ask
+func (b *OrderBook) BestAsk() (OrderBookLevel, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if len(b.asks) == 0 {
+		return OrderBookLevel{}, false
+	}
+	return b.asks[0], true
+}
+
+// Bids : the n best bids, all of them when n <= 0
+func (b *OrderBook) Bids(n int) []OrderBookLevel {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return topLevels(b.bids, n)
+}
+
+// Asks : the n best asks, all of them when n <= 0
+func (b *OrderBook) Asks(n int) []OrderBookLevel {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return topLevels(b.asks, n)
+}
+
+func topLevels(levels []OrderBookLevel, n int) []OrderBookLevel {
+	if n <= 0 || n > len(levels) {
+		n = len(levels)
+	}
+	top := make([]OrderBookLevel, n)
+	copy(top, levels[:n])
+	return top
+}
+
+// SizeAt : size of the level at price on side, bybit.SideBuy for the bids. Zero when there is none
+func (b *OrderBook) SizeAt(side bybit.Side, price bybit.Decimal) bybit.Decimal {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	levels, descending := b.sideLocked(side)
+	if i, found := searchLevel(levels, price.Decimal(), descending); found {
+		return levels[i].Size
+	}
+	return bybit.DecimalFrom(decimal.Zero)
+}
+
+// DepthAt : total size on side from the best level to price, inclusive
+func (b *OrderBook) DepthAt(side bybit.Side, price bybit.Decimal) bybit.Decimal {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	levels, descending := b.sideLocked(side)
+	i, found := searchLevel(levels, price.Decimal(), descending)
+	if found {
+		i++
+	}
+	total := decimal.Zero
+	for _, level := range levels[:i] {
+		total = total.Add(level.Size.Decimal())
+	}
+	return bybit.DecimalFrom(total)
+}
+
+func (b *OrderBook) sideLocked(side bybit.Side) ([]OrderBookLevel, bool) {
+	if side == bybit.SideBuy {
+		return b.bids, true
+	}
+	return b.asks, false
+}
@@ -0,0 +1,337 @@
+package wsv5
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sngyai/go-bybit"
+)
+
+var testOrderBookKey = PublicOrderBookParamKey{Depth: 50, Symbol: bybit.SymbolV5BTCUSDT}
+
+func testOrderBookData(updateID int, seq int, bids [][2]string, asks [][2]string) PublicOrderBookData {
+	data := PublicOrderBookData{
+		Symbol:   bybit.SymbolV5BTCUSDT,
+		Bids:     make(PublicOrderBookBids, len(bids)),
+		Asks:     make(PublicOrderBookAsks, len(asks)),
+		UpdateID: updateID,
+		Seq:      seq,
+	}
+	for i, level := range bids {
+		data.Bids[i].Price = bybit.MustDecimal(level[0])
+		data.Bids[i].Size = bybit.MustDecimal(level[1])
+	}
+	for i, level := range asks {
+		data.Asks[i].Price = bybit.MustDecimal(level[0])
+		data.Asks[i].Size = bybit.MustDecimal(level[1])
+	}
+	return data
+}
+
+func testOrderBookMessage(typ string, updateID int, seq int, bids [][2]string, asks [][2]string) PublicOrderBookResponse {
+	return PublicOrderBookResponse{
+		Topic: testOrderBookKey.Topic(),
+		Type:  typ,
+		Data:  testOrderBookData(updateID, seq, bids, asks),
+	}
+}
+
+type testResubscriber struct {
+	book  *OrderBook
+	count int
+	// locked : called while the book was locked, which would block the book on the websocket write
+	locked bool
+}
+
+func (r *testResubscriber) ResubscribeOrderBook(PublicOrderBookParamKey) error {
+	r.count++
+	if r.book.mu.TryLock() {
+		r.book.mu.Unlock()
+	} else {
+		r.locked = true
+	}
+	return nil
+}
+
+// testBookStep : applies message, or waits for the snapshot func when wait is set
+type testBookStep struct {
+	message PublicOrderBookResponse
+	wait    bool
+	wantErr error
+}
+
+func TestOrderBookApply(t *testing.T) {
+	snapshot := testOrderBookMessage("snapshot", 10, 100,
+		[][2]string{{"99", "1"}, {"98", "2"}},
+		[][2]string{{"101", "1"}, {"102", "2"}},
+	)
+
+	tests := []struct {
+		name         string
+		snapshot     *PublicOrderBookData
+		resubscriber bool
+		steps        []testBookStep
+		wantSynced   bool
+		wantUpdateID int
+		wantBids     [][2]string
+		wantAsks     [][2]string
+		wantResubs   int
+		// wantSnapshots : calls of the snapshot func
+		wantSnapshots int
+	}{
+		{
+			name: "snapshot",
+			steps: []testBookStep{
+				{message: snapshot},
+			},
+			wantSynced:   true,
+			wantUpdateID: 10,
+			wantBids:     [][2]string{{"99", "1"}, {"98", "2"}},
+			wantAsks:     [][2]string{{"101", "1"}, {"102", "2"}},
+		},
+		{
+			name: "deltas insert, update and delete levels",
+			steps: []testBookStep{
+				{message: snapshot},
+				{message: testOrderBookMessage("delta", 11, 101, [][2]string{{"99.5", "3"}, {"98", "0"}}, nil)},
+				{message: testOrderBookMessage("delta", 12, 105, nil, [][2]string{{"101", "4"}, {"100.5", "1"}, {"103", "0"}})},
+			},
+			wantSynced:   true,
+			wantUpdateID: 12,
+			wantBids:     [][2]string{{"99.5", "3"}, {"99", "1"}},
+			wantAsks:     [][2]string{{"100.5", "1"}, {"101", "4"}, {"102", "2"}},
+		},
+		{
+			name: "new snapshot replaces the book",
+			steps: []testBookStep{
+				{message: snapshot},
+				{message: testOrderBookMessage("snapshot", 1, 200, [][2]string{{"90", "1"}}, nil)},
+			},
+			wantSynced:   true,
+			wantUpdateID: 1,
+			wantBids:     [][2]string{{"90", "1"}},
+		},
+		{
+			name: "delta before the first snapshot",
+			steps: []testBookStep{
+				{message: testOrderBookMessage("delta", 11, 101, [][2]string{{"99", "1"}}, nil), wantErr: ErrOrderBookGap},
+			},
+		},
+		{
+			name: "update ID gap without a way to resync",
+			steps: []testBookStep{
+				{message: snapshot},
+				{message: testOrderBookMessage("delta", 12, 101, [][2]string{{"99", "5"}}, nil), wantErr: ErrOrderBookGap},
+			},
+			wantUpdateID: 10,
+			wantBids:     [][2]string{{"99", "1"}, {"98", "2"}},
+			wantAsks:     [][2]string{{"101", "1"}, {"102", "2"}},
+		},
+		{
+			name: "seq regression",
+			steps: []testBookStep{
+				{message: snapshot},
+				{message: testOrderBookMessage("delta", 11, 99, [][2]string{{"99", "5"}}, nil), wantErr: ErrOrderBookGap},
+			},
+			wantUpdateID: 10,
+			wantBids:     [][2]string{{"99", "1"}, {"98", "2"}},
+			wantAsks:     [][2]string{{"101", "1"}, {"102", "2"}},
+		},
+		{
+			name:         "gap resubscribes, and the fresh snapshot resyncs",
+			resubscriber: true,
+			steps: []testBookStep{
+				{message: snapshot},
+				{message: testOrderBookMessage("delta", 12, 101, [][2]string{{"99", "5"}}, nil)},
+				{message: testOrderBookMessage("delta", 13, 102, [][2]string{{"99", "6"}}, nil)},
+				{message: testOrderBookMessage("snapshot", 20, 110, [][2]string{{"97", "1"}}, nil)},
+				{message: testOrderBookMessage("delta", 21, 111, [][2]string{{"97", "2"}}, nil)},
+			},
+			wantSynced:   true,
+			wantUpdateID: 21,
+			wantBids:     [][2]string{{"97", "2"}},
+			wantResubs:   1,
+		},
+		{
+			name:     "gap resyncs from the snapshot func, dropping the deltas it includes",
+			snapshot: &[]PublicOrderBookData{testOrderBookData(100, 0, [][2]string{{"95", "1"}}, nil)}[0],
+			steps: []testBookStep{
+				{message: snapshot},
+				{message: testOrderBookMessage("delta", 12, 101, [][2]string{{"99", "5"}}, nil)},
+				{wait: true},
+				{message: testOrderBookMessage("delta", 100, 150, [][2]string{{"95", "9"}}, nil)},
+				{message: testOrderBookMessage("delta", 101, 151, [][2]string{{"95", "2"}}, nil)},
+			},
+			wantSynced:    true,
+			wantUpdateID:  101,
+			wantBids:      [][2]string{{"95", "2"}},
+			wantSnapshots: 1,
+		},
+		{
+			name:     "gap after the snapshot func resyncs again",
+			snapshot: &[]PublicOrderBookData{testOrderBookData(100, 0, [][2]string{{"95", "1"}}, nil)}[0],
+			steps: []testBookStep{
+				{message: snapshot},
+				{message: testOrderBookMessage("delta", 12, 101, nil, nil)},
+				{wait: true},
+				{message: testOrderBookMessage("delta", 150, 150, [][2]string{{"95", "9"}}, nil)},
+				{wait: true},
+			},
+			wantSynced:    true,
+			wantUpdateID:  100,
+			wantBids:      [][2]string{{"95", "1"}},
+			wantSnapshots: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			book := NewOrderBook(testOrderBookKey)
+			snapshots := 0
+			if tt.snapshot != nil {
+				book.WithSnapshotFunc(func() (*PublicOrderBookData, error) {
+					snapshots++
+					data := *tt.snapshot
+					return &data, nil
+				})
+			}
+			resubscriber := &testResubscriber{book: book}
+			if tt.resubscriber {
+				book.resubscriber = resubscriber
+			}
+
+			for i, step := range tt.steps {
+				if step.wait {
+					waitOrderBookResync(t, book)
+					continue
+				}
+				if err := book.Apply(step.message); !errors.Is(err, step.wantErr) {
+					t.Fatalf("step %d: got error %v, want %v", i, err, step.wantErr)
+				}
+			}
+
+			if got := book.Synced(); got != tt.wantSynced {
+				t.Errorf("Synced() = %v, want %v", got, tt.wantSynced)
+			}
+			if got := book.UpdateID(); got != tt.wantUpdateID {
+				t.Errorf("UpdateID() = %d, want %d", got, tt.wantUpdateID)
+			}
+			assertOrderBookLevels(t, "bids", book.Bids(0), tt.wantBids)
+			assertOrderBookLevels(t, "asks", book.Asks(0), tt.wantAsks)
+			if snapshots != tt.wantSnapshots {
+				t.Errorf("called the snapshot func %d times, want %d", snapshots, tt.wantSnapshots)
+			}
+			if resubscriber.count != tt.wantResubs {
+				t.Errorf("resubscribed %d times, want %d", resubscriber.count, tt.wantResubs)
+			}
+			if resubscriber.locked {
+				t.Error("resubscribed while holding the lock of the book")
+			}
+		})
+	}
+}
+
+func TestOrderBookSnapshotFuncReplaysPendingDeltas(t *testing.T) {
+	release := make(chan struct{})
+	book := NewOrderBook(testOrderBookKey).WithSnapshotFunc(func() (*PublicOrderBookData, error) {
+		<-release
+		data := testOrderBookData(20, 0, [][2]string{{"95", "1"}}, nil)
+		return &data, nil
+	})
+
+	steps := []PublicOrderBookResponse{
+		testOrderBookMessage("snapshot", 10, 100, [][2]string{{"99", "1"}}, nil),
+		testOrderBookMessage("delta", 12, 101, nil, nil),
+		// received while the snapshot func runs
+		testOrderBookMessage("delta", 20, 110, [][2]string{{"95", "7"}}, nil),
+		testOrderBookMessage("delta", 21, 111, [][2]string{{"94", "1"}}, nil),
+		testOrderBookMessage("delta", 22, 112, [][2]string{{"95", "3"}}, nil),
+	}
+	for i, message := range steps {
+		if err := book.Apply(message); err != nil {
+			t.Fatalf("step %d: %v", i, err)
+		}
+	}
+	if book.Synced() {
+		t.Fatal("synced while the snapshot func runs")
+	}
+
+	close(release)
+	waitOrderBookResync(t, book)
+
+	if !book.Synced() || book.UpdateID() != 22 {
+		t.Fatalf("got synced %v at %d, want synced at 22", book.Synced(), book.UpdateID())
+	}
+	assertOrderBookLevels(t, "bids", book.Bids(0), [][2]string{{"95", "3"}, {"94", "1"}})
+}
+
+func TestOrderBookQueries(t *testing.T) {
+	book := NewOrderBook(testOrderBookKey)
+	if _, ok := book.BestBid(); ok {
+		t.Error("BestBid() of an empty book")
+	}
+	if err := book.Apply(testOrderBookMessage("snapshot", 1, 1,
+		[][2]string{{"99", "1"}, {"98", "2"}, {"97", "3"}},
+		[][2]string{{"101", "1.5"}, {"102", "2.5"}},
+	)); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		got  bybit.Decimal
+		want string
+	}{
+		{name: "best bid", got: mustLevel(book.BestBid()).Price, want: "99"},
+		{name: "best ask", got: mustLevel(book.BestAsk()).Price, want: "101"},
+		{name: "size at a bid", got: book.SizeAt(bybit.SideBuy, bybit.MustDecimal("98")), want: "2"},
+		{name: "size at a missing level", got: book.SizeAt(bybit.SideSell, bybit.MustDecimal("101.5")), want: "0"},
+		{name: "depth at a bid level", got: book.DepthAt(bybit.SideBuy, bybit.MustDecimal("98")), want: "3"},
+		{name: "depth between ask levels", got: book.DepthAt(bybit.SideSell, bybit.MustDecimal("101.5")), want: "1.5"},
+		{name: "depth beyond the asks", got: book.DepthAt(bybit.SideSell, bybit.MustDecimal("200")), want: "4"},
+		{name: "depth before the best bid", got: book.DepthAt(bybit.SideBuy, bybit.MustDecimal("100")), want: "0"},
+	}
+	for _, tt := range tests {
+		if !tt.got.Decimal().Equal(bybit.MustDecimal(tt.want).Decimal()) {
+			t.Errorf("%s = %s, want %s", tt.name, tt.got, tt.want)
+		}
+	}
+
+	assertOrderBookLevels(t, "top bids", book.Bids(2), [][2]string{{"99", "1"}, {"98", "2"}})
+	assertOrderBookLevels(t, "top asks", book.Asks(5), [][2]string{{"101", "1.5"}, {"102", "2.5"}})
+}
+
+func mustLevel(level OrderBookLevel, ok bool) OrderBookLevel {
+	if !ok {
+		panic("no level")
+	}
+	return level
+}
+
+// waitOrderBookResync : until the snapshot func started by the gap has synced the book, and replayed
+// the pending deltas which it does under the same lock
+func waitOrderBookResync(t *testing.T, book *OrderBook) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for !book.Synced() {
+		if time.Now().After(deadline) {
+			t.Fatal("resync did not complete")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func assertOrderBookLevels(t *testing.T, name string, got []OrderBookLevel, want [][2]string) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("%s = %v, want %v", name, got, want)
+	}
+	for i, level := range got {
+		if !level.Price.Decimal().Equal(bybit.MustDecimal(want[i][0]).Decimal()) || !level.Size.Decimal().Equal(bybit.MustDecimal(want[i][1]).Decimal()) {
+			t.Fatalf("%s = %v, want %v", name, got, want)
+		}
+	}
+}
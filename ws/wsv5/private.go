@@ -4,9 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"log"
-	"os"
-	"os/signal"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -16,6 +15,7 @@ import (
 // PrivateServiceI :
 type PrivateServiceI interface {
 	Start(context.Context, ErrHandler) error
+	Supervise(context.Context, *ReconnectPolicy, StateHandler) error
 	Subscribe() error
 	Run() error
 	Ping() error
@@ -28,12 +28,22 @@ type PrivateServiceI interface {
 	SubscribePosition(
 		func(PrivatePositionResponse) error,
 	) (func() error, error)
+
+	SubscribeWallet(
+		func(PrivateWalletResponse) error,
+	) (func() error, error)
 }
 
 // PrivateService :
 type PrivateService struct {
 	client     *ws.WebSocketClient
 	connection *websocket.Conn
+	path       string
+
+	writeMu sync.Mutex // guards connection, which redial replaces, and its writes
+	closing bool
+
+	paramMu sync.Mutex
 
 	paramOrderMap    map[PrivateParamKey]func(PrivateOrderResponse) error
 	paramPositionMap map[PrivateParamKey]func(PrivatePositionResponse) error
@@ -95,7 +105,7 @@ func (s *PrivateService) Subscribe() error {
 	if err != nil {
 		return err
 	}
-	if err := s.connection.WriteMessage(websocket.TextMessage, param); err != nil {
+	if err := s.writeMessage(websocket.TextMessage, param); err != nil {
 		return err
 	}
 	return nil
@@ -104,9 +114,13 @@ func (s *PrivateService) Subscribe() error {
 // ErrHandler :
 type ErrHandler func(isWebsocketClosed bool, err error)
 
-// Start :
+// Start : serves the connection until it drops, or closes it once ctx is done.
+// Like Supervise, it leaves signal handling to the caller, e.g. with signal.NotifyContext
 func (s *PrivateService) Start(ctx context.Context, errHandler ErrHandler) error {
+	end := s.client.ObserveSessionStarted(ctx, s.path)
+
 	done := make(chan struct{})
+	var runErr error
 
 	go func() {
 		defer close(done)
@@ -119,6 +133,9 @@ func (s *PrivateService) Start(ctx context.Context, errHandler ErrHandler) error
 
 		for {
 			if err := s.Run(); err != nil {
+				if !ws.IsErrWebsocketClosed(err) {
+					runErr = err
+				}
 				errHandler(ws.IsErrWebsocketClosed(err), err)
 				return
 			}
@@ -128,27 +145,26 @@ func (s *PrivateService) Start(ctx context.Context, errHandler ErrHandler) error
 	ticker := time.NewTicker(20 * time.Second)
 	defer ticker.Stop()
 
-	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
-	defer stop()
-
 	for {
 		select {
 		case <-done:
+			end(runErr)
 			return nil
 		case <-ticker.C:
 			if err := s.Ping(); err != nil {
+				end(err)
 				return err
 			}
 		case <-ctx.Done():
-			log.Println("interrupt")
-
 			if err := s.Close(); err != nil {
+				end(err)
 				return err
 			}
 			select {
 			case <-done:
 			case <-time.After(time.Second):
 			}
+			end(nil)
 			return nil
 		}
 	}
@@ -173,9 +189,10 @@ func (s *PrivateService) Run() error {
 		}
 		f, err := s.retrieveOrderFunc(resp.Key())
 		if err != nil {
+			s.client.ObserveMessageDropped(s.path, string(topic), err.Error())
 			return err
 		}
-		if err := f(resp); err != nil {
+		if err := s.handle(string(resp.Topic), func() error { return f(resp) }); err != nil {
 			return err
 		}
 	case PrivateTopicPosition:
@@ -185,19 +202,45 @@ func (s *PrivateService) Run() error {
 		}
 		f, err := s.retrievePositionFunc(resp.Key())
 		if err != nil {
+			s.client.ObserveMessageDropped(s.path, string(topic), err.Error())
+			return err
+		}
+		if err := s.handle(string(resp.Topic), func() error { return f(resp) }); err != nil {
+			return err
+		}
+	case PrivateTopicWallet:
+		var resp PrivateWalletResponse
+		if err := s.parseResponse(message, &resp); err != nil {
+			return err
+		}
+		f, err := s.retrieveWalletFunc(resp.Key())
+		if err != nil {
+			s.client.ObserveMessageDropped(s.path, string(topic), err.Error())
 			return err
 		}
-		if err := f(resp); err != nil {
+		if err := s.handle(string(resp.Topic), func() error { return f(resp) }); err != nil {
 			return err
 		}
+	default:
+		if topic != "" {
+			s.client.ObserveMessageDropped(s.path, string(topic), "unhandled topic")
+		}
 	}
 
 	return nil
 }
 
+// handle : calls the handler f, reporting its latency to the observers
+func (s *PrivateService) handle(topic string, f func() error) error {
+	start := time.Now()
+	err := f()
+	s.client.ObserveMessageHandled(s.path, topic, time.Since(start), err)
+	return err
+}
+
 // Ping :
 func (s *PrivateService) Ping() error {
-	if err := s.connection.WriteMessage(websocket.PingMessage, nil); err != nil {
+	if err := s.writeMessage(websocket.PingMessage, nil); err != nil {
 		return err
 	}
 	return nil
@@ -205,8 +248,133 @@ func (s *PrivateService) Ping() error {
 
 // Close :
 func (s *PrivateService) Close() error {
-	if err := s.connection.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")); err != nil {
+	s.writeMu.Lock()
+	s.closing = true
+	s.writeMu.Unlock()
+
+	if err := s.writeMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")); err != nil {
 		return err
 	}
 	return nil
 }
+
+// Supervise : serves the connection like Start, but redials it with backoff whenever it drops,
+// authenticating it and subscribing again to every topic.
+// Returns once ctx is done, Close is called, or policy gives up.
+func (s *PrivateService) Supervise(ctx context.Context, policy *ReconnectPolicy, stateHandler StateHandler) error {
+	return supervise(ctx, s.client, s.path, s, policy, stateHandler)
+}
+
+// writeMessage : one writer at a time, to the current connection
+func (s *PrivateService) writeMessage(messageType int, data []byte) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	return s.connection.WriteMessage(messageType, data)
+}
+
+// conn :
+func (s *PrivateService) conn() *websocket.Conn {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	return s.connection
+}
+
+// isClosing :
+func (s *PrivateService) isClosing() bool {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	return s.closing
+}
+
+// redial : dials a new connection, authenticates it and subscribes to the registered topics on it
+func (s *PrivateService) redial(ctx context.Context) error {
+	c, _, err := s.client.Dialer.DialContext(ctx, s.client.BaseURL+s.path, nil)
+	if err != nil {
+		return err
+	}
+	s.writeMu.Lock()
+	if s.closing {
+		s.writeMu.Unlock()
+		_ = c.Close()
+		return errServiceClosing
+	}
+	s.connection = c
+	s.writeMu.Unlock()
+
+	s.paramMu.Lock()
+	topics := make([]string, 0, len(s.paramOrderMap)+len(s.paramPositionMap)+len(s.paramWalletMap))
+	for key := range s.paramOrderMap {
+		topics = append(topics, string(key.Topic))
+	}
+	for key := range s.paramPositionMap {
+		topics = append(topics, string(key.Topic))
+	}
+	for key := range s.paramWalletMap {
+		topics = append(topics, string(key.Topic))
+	}
+	s.paramMu.Unlock()
+
+	sort.Strings(topics)
+	if err := s.Subscribe(); err != nil {
+		_ = c.Close()
+		return err
+	}
+	if err := awaitAuth(ctx, c); err != nil {
+		_ = c.Close()
+		return err
+	}
+	if err := subscribeAll(s.writeMessage, topics); err != nil {
+		_ = c.Close()
+		return err
+	}
+	return nil
+}
+
+// authTimeout : how long redial waits for the answer to the auth request
+const authTimeout = 10 * time.Second
+
+// awaitAuth : reads c until the answer to the auth request, so that the topics are subscribed again
+// only once authenticated. Nothing else reads c before redial returns
+func awaitAuth(ctx context.Context, c *websocket.Conn) error {
+	deadline := time.Now().Add(authTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	_ = c.SetReadDeadline(deadline)
+	defer c.SetReadDeadline(time.Time{})
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = c.Close()
+		case <-stop:
+		}
+	}()
+
+	for {
+		_, message, err := c.ReadMessage()
+		if err != nil {
+			return err
+		}
+		var res struct {
+			Op      string `json:"op"`
+			Success bool   `json:"success"`
+			RetMsg  string `json:"ret_msg"`
+		}
+		if err := json.Unmarshal(message, &res); err != nil {
+			return err
+		}
+		if res.Op != "auth" {
+			continue
+		}
+		if !res.Success {
+			return errors.New("auth failed: " + res.RetMsg)
+		}
+		return nil
+	}
+}
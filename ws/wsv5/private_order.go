@@ -29,7 +29,7 @@ func (s *PrivateService) SubscribeOrder(
 	if err != nil {
 		return nil, err
 	}
-	if err := s.connection.WriteMessage(websocket.TextMessage, buf); err != nil {
+	if err := s.writeMessage(websocket.TextMessage, buf); err != nil {
 		return nil, err
 	}
 	return func() error {
@@ -44,7 +44,7 @@ func (s *PrivateService) SubscribeOrder(
 		if err != nil {
 			return err
 		}
-		if err := s.connection.WriteMessage(websocket.TextMessage, buf); err != nil {
+		if err := s.writeMessage(websocket.TextMessage, buf); err != nil {
 			return err
 		}
 		s.removeParamOrderFunc(key)
@@ -62,40 +62,40 @@ type PrivateOrderResponse struct {
 
 // PrivateOrderData :
 type PrivateOrderData struct {
-	AvgPrice           string            `json:"avgPrice"`
+	AvgPrice           bybit.Decimal     `json:"avgPrice"`
 	BlockTradeID       string            `json:"blockTradeId"`
 	CancelType         string            `json:"cancelType"`
 	Category           string            `json:"category"`
 	CloseOnTrigger     bool              `json:"closeOnTrigger"`
 	CreatedTime        string            `json:"createdTime"`
-	CumExecFee         string            `json:"cumExecFee"`
-	CumExecQty         string            `json:"cumExecQty"`
-	CumExecValue       string            `json:"cumExecValue"`
-	LeavesQty          string            `json:"leavesQty"`
-	LeavesValue        string            `json:"leavesValue"`
+	CumExecFee         bybit.Decimal     `json:"cumExecFee"`
+	CumExecQty         bybit.Decimal     `json:"cumExecQty"`
+	CumExecValue       bybit.Decimal     `json:"cumExecValue"`
+	LeavesQty          bybit.Decimal     `json:"leavesQty"`
+	LeavesValue        bybit.Decimal     `json:"leavesValue"`
 	OrderID            string            `json:"orderId"`
-	OrderIv            string            `json:"orderIv"`
+	OrderIv            bybit.Decimal     `json:"orderIv"`
 	IsLeverage         string            `json:"isLeverage"`
-	LastPriceOnCreated string            `json:"lastPriceOnCreated"`
+	LastPriceOnCreated bybit.Decimal     `json:"lastPriceOnCreated"`
 	OrderStatus        string            `json:"orderStatus"`
 	OrderLinkID        string            `json:"orderLinkId"`
 	OrderType          bybit.OrderType   `json:"orderType"`
 	PositionIdx        int               `json:"positionIdx"`
-	Price              string            `json:"price"`
-	Qty                string            `json:"qty"`
+	Price              bybit.Decimal     `json:"price"`
+	Qty                bybit.Decimal     `json:"qty"`
 	ReduceOnly         bool              `json:"reduceOnly"`
 	RejectReason       string            `json:"rejectReason"`
 	Side               bybit.Side        `json:"side"`
 	SlTriggerBy        string            `json:"slTriggerBy"`
-	StopLoss           string            `json:"stopLoss"`
+	StopLoss           bybit.Decimal     `json:"stopLoss"`
 	StopOrderType      string            `json:"stopOrderType"`
 	Symbol             bybit.SymbolV5    `json:"symbol"`
-	TakeProfit         string            `json:"takeProfit"`
+	TakeProfit         bybit.Decimal     `json:"takeProfit"`
 	TimeInForce        bybit.TimeInForce `json:"timeInForce"`
 	TpTriggerBy        string            `json:"tpTriggerBy"`
 	TriggerBy          string            `json:"triggerBy"`
 	TriggerDirection   int               `json:"triggerDirection"`
-	TriggerPrice       string            `json:"triggerPrice"`
+	TriggerPrice       bybit.Decimal     `json:"triggerPrice"`
 	UpdatedTime        string            `json:"updatedTime"`
 }
 
@@ -108,6 +108,9 @@ func (r *PrivateOrderResponse) Key() PrivateParamKey {
 
 // addParamOrderFunc :
 func (s *PrivateService) addParamOrderFunc(param PrivateParamKey, f func(PrivateOrderResponse) error) error {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
 	if _, exist := s.paramOrderMap[param]; exist {
 		return errors.New("already registered for this param")
 	}
@@ -117,11 +120,17 @@ func (s *PrivateService) addParamOrderFunc(param PrivateParamKey, f func(Private
 
 // removeParamOrderFunc :
 func (s *PrivateService) removeParamOrderFunc(key PrivateParamKey) {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
 	delete(s.paramOrderMap, key)
 }
 
 // retrieveOrderFunc :
 func (s *PrivateService) retrieveOrderFunc(key PrivateParamKey) (func(PrivateOrderResponse) error, error) {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
 	f, exist := s.paramOrderMap[key]
 	if !exist {
 		return nil, errors.New("order func not found")
@@ -29,7 +29,7 @@ func (s *PrivateService) SubscribePosition(
 	if err != nil {
 		return nil, err
 	}
-	if err := s.connection.WriteMessage(websocket.TextMessage, buf); err != nil {
+	if err := s.writeMessage(websocket.TextMessage, buf); err != nil {
 		return nil, err
 	}
 	return func() error {
@@ -44,7 +44,7 @@ func (s *PrivateService) SubscribePosition(
 		if err != nil {
 			return err
 		}
-		if err := s.connection.WriteMessage(websocket.TextMessage, []byte(buf)); err != nil {
+		if err := s.writeMessage(websocket.TextMessage, []byte(buf)); err != nil {
 			return err
 		}
 		s.removeParamPositionFunc(key)
@@ -67,27 +67,27 @@ type PrivatePositionData struct {
 	TpSlMode        bybit.TpSlMode   `json:"tpSlMode"`
 	TradeMode       int              `json:"tradeMode"`
 	RiskID          int              `json:"riskId"`
-	RiskLimitValue  string           `json:"riskLimitValue"`
+	RiskLimitValue  bybit.Decimal    `json:"riskLimitValue"`
 	Symbol          bybit.SymbolV5   `json:"symbol"`
 	Side            bybit.Side       `json:"side"`
-	Size            string           `json:"size"`
-	EntryPrice      string           `json:"entryPrice"`
-	Leverage        string           `json:"leverage"`
-	PositionValue   string           `json:"positionValue"`
-	MarkPrice       string           `json:"markPrice"`
-	PositionBalance string           `json:"positionBalance"`
-	PositionIM      string           `json:"positionIM"`
-	PositionMM      string           `json:"positionMM"`
-	TakeProfit      string           `json:"takeProfit"`
-	StopLoss        string           `json:"stopLoss"`
-	TrailingStop    string           `json:"trailingStop"`
-	UnrealisedPnl   string           `json:"unrealisedPnl"`
-	CumRealisedPnl  string           `json:"cumRealisedPnl"`
+	Size            bybit.Decimal    `json:"size"`
+	EntryPrice      bybit.Decimal    `json:"entryPrice"`
+	Leverage        bybit.Decimal    `json:"leverage"`
+	PositionValue   bybit.Decimal    `json:"positionValue"`
+	MarkPrice       bybit.Decimal    `json:"markPrice"`
+	PositionBalance bybit.Decimal    `json:"positionBalance"`
+	PositionIM      bybit.Decimal    `json:"positionIM"`
+	PositionMM      bybit.Decimal    `json:"positionMM"`
+	TakeProfit      bybit.Decimal    `json:"takeProfit"`
+	StopLoss        bybit.Decimal    `json:"stopLoss"`
+	TrailingStop    bybit.Decimal    `json:"trailingStop"`
+	UnrealisedPnl   bybit.Decimal    `json:"unrealisedPnl"`
+	CumRealisedPnl  bybit.Decimal    `json:"cumRealisedPnl"`
 	CreatedTime     string           `json:"CreatedTime"`
 	UpdatedTime     string           `json:"updatedTime"`
 	TpslMode        bybit.TpSlMode   `json:"tpslMode"`
-	LiqPrice        string           `json:"liqPrice"`
-	BustPrice       string           `json:"bustPrice"`
+	LiqPrice        bybit.Decimal    `json:"liqPrice"`
+	BustPrice       bybit.Decimal    `json:"bustPrice"`
 	Category        bybit.CategoryV5 `json:"category"`
 	PositionStatus  string           `json:"positionStatus"`
 }
@@ -101,6 +101,9 @@ func (r *PrivatePositionResponse) Key() PrivateParamKey {
 
 // addParamPositionFunc :
 func (s *PrivateService) addParamPositionFunc(param PrivateParamKey, f func(PrivatePositionResponse) error) error {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
 	if _, exist := s.paramPositionMap[param]; exist {
 		return errors.New("already registered for this param")
 	}
@@ -110,11 +113,17 @@ func (s *PrivateService) addParamPositionFunc(param PrivateParamKey, f func(Priv
 
 // removeParamPositionFunc :
 func (s *PrivateService) removeParamPositionFunc(key PrivateParamKey) {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
 	delete(s.paramPositionMap, key)
 }
 
 // retrievePositionFunc :
 func (s *PrivateService) retrievePositionFunc(key PrivateParamKey) (func(PrivatePositionResponse) error, error) {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
 	f, exist := s.paramPositionMap[key]
 	if !exist {
 		return nil, errors.New("position func not found")
@@ -29,7 +29,7 @@ func (s *PrivateService) SubscribeWallet(
 	if err != nil {
 		return nil, err
 	}
-	if err := s.connection.WriteMessage(websocket.TextMessage, buf); err != nil {
+	if err := s.writeMessage(websocket.TextMessage, buf); err != nil {
 		return nil, err
 	}
 	return func() error {
@@ -44,7 +44,7 @@ func (s *PrivateService) SubscribeWallet(
 		if err != nil {
 			return err
 		}
-		if err := s.connection.WriteMessage(websocket.TextMessage, []byte(buf)); err != nil {
+		if err := s.writeMessage(websocket.TextMessage, []byte(buf)); err != nil {
 			return err
 		}
 		s.removeParamWalletFunc(key)
@@ -62,34 +62,34 @@ type PrivateWalletResponse struct {
 
 // PrivateWalletData :
 type PrivateWalletData struct {
-	AccountIMRate          string              `json:"accountIMRate"`
-	AccountMMRate          string              `json:"accountMMRate"`
-	TotalEquity            string              `json:"totalEquity"`
-	TotalWalletBalance     string              `json:"totalWalletBalance"`
-	TotalMarginBalance     string              `json:"totalMarginBalance"`
-	TotalAvailableBalance  string              `json:"totalAvailableBalance"`
-	TotalPerpUPL           string              `json:"totalPerpUPL"`
-	TotalInitialMargin     string              `json:"totalInitialMargin"`
-	TotalMaintenanceMargin string              `json:"totalMaintenanceMargin"`
+	AccountIMRate          bybit.Decimal       `json:"accountIMRate"`
+	AccountMMRate          bybit.Decimal       `json:"accountMMRate"`
+	TotalEquity            bybit.Decimal       `json:"totalEquity"`
+	TotalWalletBalance     bybit.Decimal       `json:"totalWalletBalance"`
+	TotalMarginBalance     bybit.Decimal       `json:"totalMarginBalance"`
+	TotalAvailableBalance  bybit.Decimal       `json:"totalAvailableBalance"`
+	TotalPerpUPL           bybit.Decimal       `json:"totalPerpUPL"`
+	TotalInitialMargin     bybit.Decimal       `json:"totalInitialMargin"`
+	TotalMaintenanceMargin bybit.Decimal       `json:"totalMaintenanceMargin"`
 	AccountType            bybit.AccountType   `json:"accountType"`
 	Coins                  []PrivateWalletCoin `json:"coin"`
 }
 
 // PrivateWalletCoin :
 type PrivateWalletCoin struct {
-	Coin                bybit.Coin `json:"coin"`
-	Equity              string     `json:"equity"`
-	UsdValue            string     `json:"usdValue"`
-	WalletBalance       string     `json:"walletBalance"`
-	AvailableToWithdraw string     `json:"availableToWithdraw"`
-	AvailableToBorrow   string     `json:"availableToBorrow"`
-	BorrowAmount        string     `json:"borrowAmount"`
-	AccruedInterest     string     `json:"accruedInterest"`
-	TotalOrderIM        string     `json:"totalOrderIM"`
-	TotalPositionIM     string     `json:"totalPositionIM"`
-	TotalPositionMM     string     `json:"totalPositionMM"`
-	UnrealisedPnl       string     `json:"unrealisedPnl"`
-	CumRealisedPnl      string     `json:"cumRealisedPnl"`
+	Coin                bybit.Coin    `json:"coin"`
+	Equity              bybit.Decimal `json:"equity"`
+	UsdValue            bybit.Decimal `json:"usdValue"`
+	WalletBalance       bybit.Decimal `json:"walletBalance"`
+	AvailableToWithdraw bybit.Decimal `json:"availableToWithdraw"`
+	AvailableToBorrow   bybit.Decimal `json:"availableToBorrow"`
+	BorrowAmount        bybit.Decimal `json:"borrowAmount"`
+	AccruedInterest     bybit.Decimal `json:"accruedInterest"`
+	TotalOrderIM        bybit.Decimal `json:"totalOrderIM"`
+	TotalPositionIM     bybit.Decimal `json:"totalPositionIM"`
+	TotalPositionMM     bybit.Decimal `json:"totalPositionMM"`
+	UnrealisedPnl       bybit.Decimal `json:"unrealisedPnl"`
+	CumRealisedPnl      bybit.Decimal `json:"cumRealisedPnl"`
 }
 
 // Key :
@@ -101,6 +101,9 @@ func (r *PrivateWalletResponse) Key() PrivateParamKey {
 
 // addParamWalletFunc :
 func (s *PrivateService) addParamWalletFunc(param PrivateParamKey, f func(PrivateWalletResponse) error) error {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
 	if _, exist := s.paramWalletMap[param]; exist {
 		return errors.New("already registered for this param")
 	}
@@ -110,11 +113,17 @@ func (s *PrivateService) addParamWalletFunc(param PrivateParamKey, f func(Privat
 
 // removeParamWalletFunc :
 func (s *PrivateService) removeParamWalletFunc(key PrivateParamKey) {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
 	delete(s.paramWalletMap, key)
 }
 
 // retrieveWalletFunc :
 func (s *PrivateService) retrieveWalletFunc(key PrivateParamKey) (func(PrivateWalletResponse) error, error) {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
 	f, exist := s.paramWalletMap[key]
 	if !exist {
 		return nil, errors.New("wallet func not found")
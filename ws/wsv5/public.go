@@ -5,9 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"os"
-	"os/signal"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -18,6 +18,7 @@ import (
 // PublicServiceI :
 type PublicServiceI interface {
 	Start(context.Context, ErrHandler) error
+	Supervise(context.Context, *ReconnectPolicy, StateHandler) error
 	Run() error
 	Ping() error
 	Close() error
@@ -30,15 +31,51 @@ type PublicServiceI interface {
 		key PublicTickersParamKey,
 		f func(PublicTickersResponse) error,
 	) (func() error, error)
+	SubscribeTrade(
+		key PublicTradeParamKey,
+		f func(PublicTradeResponse) error,
+	) (func() error, error)
+	SubscribeKline(
+		key PublicKlineParamKey,
+		f func(PublicKlineResponse) error,
+	) (func() error, error)
+	SubscribeLiquidation(
+		key PublicLiquidationParamKey,
+		f func(PublicLiquidationResponse) error,
+	) (func() error, error)
+	SubscribeLTKline(
+		key PublicLTKlineParamKey,
+		f func(PublicLTKlineResponse) error,
+	) (func() error, error)
+	SubscribeLTTicker(
+		key PublicLTTickerParamKey,
+		f func(PublicLTTickerResponse) error,
+	) (func() error, error)
+	SubscribeLTNav(
+		key PublicLTNavParamKey,
+		f func(PublicLTNavResponse) error,
+	) (func() error, error)
 }
 
 // PublicService :
 type PublicService struct {
 	client     *ws.WebSocketClient
 	connection *websocket.Conn
+	path       string
+
+	writeMu sync.Mutex // guards connection, which redial replaces, and its writes
+	closing bool
 
-	paramOrderBookMap map[PublicOrderBookParamKey]func(PublicOrderBookResponse) error
-	paramTickersMap   map[PublicTickersParamKey]func(PublicTickersResponse) error
+	paramMu sync.Mutex
+
+	paramOrderBookMap   map[PublicOrderBookParamKey]func(PublicOrderBookResponse) error
+	paramTickersMap     map[PublicTickersParamKey]func(PublicTickersResponse) error
+	paramTradeMap       map[PublicTradeParamKey]func(PublicTradeResponse) error
+	paramKlineMap       map[PublicKlineParamKey]func(PublicKlineResponse) error
+	paramLiquidationMap map[PublicLiquidationParamKey]func(PublicLiquidationResponse) error
+	paramLTKlineMap     map[PublicLTKlineParamKey]func(PublicLTKlineResponse) error
+	paramLTTickerMap    map[PublicLTTickerParamKey]func(PublicLTTickerResponse) error
+	paramLTNavMap       map[PublicLTNavParamKey]func(PublicLTNavResponse) error
 }
 
 const (
@@ -58,6 +95,18 @@ const (
 	// PublicTopicOrderBook :
 	PublicTopicOrderBook = "orderbook"
 	PublicTopicTickers   = "tickers"
+	// PublicTopicTrade :
+	PublicTopicTrade = "publicTrade"
+	// PublicTopicKline :
+	PublicTopicKline = "kline"
+	// PublicTopicLiquidation :
+	PublicTopicLiquidation = "liquidation"
+	// PublicTopicLTKline : kline of a leveraged token
+	PublicTopicLTKline = "kline_lt"
+	// PublicTopicLTTicker : ticker of a leveraged token
+	PublicTopicLTTicker = "tickers_lt"
+	// PublicTopicLTNav : net asset value of a leveraged token
+	PublicTopicLTNav = "lt"
 )
 
 // judgeTopic :
@@ -67,11 +116,11 @@ func (s *PublicService) judgeTopic(respBody []byte) (PublicTopic, error) {
 		return "", err
 	}
 	if topic, ok := parsedData["topic"].(string); ok {
-		switch {
-		case strings.Contains(topic, "orderbook"):
-			return PublicTopicOrderBook, nil
-		case strings.Contains(topic, "tickers"):
-			return PublicTopicTickers, nil
+		// match the name before the first ".", as e.g. "tickers_lt.BTC3LUSDT" contains "tickers"
+		switch name := strings.SplitN(topic, ".", 2)[0]; name {
+		case PublicTopicOrderBook, PublicTopicTickers, PublicTopicTrade, PublicTopicKline,
+			PublicTopicLiquidation, PublicTopicLTKline, PublicTopicLTTicker, PublicTopicLTNav:
+			return PublicTopic(name), nil
 		default:
 			return PublicTopic(topic), nil
 		}
@@ -87,9 +136,13 @@ func (s *PublicService) parseResponse(respBody []byte, response interface{}) err
 	return nil
 }
 
-// Start :
+// Start : serves the connection until it drops, or closes it once ctx is done.
+// Like Supervise, it leaves signal handling to the caller, e.g. with signal.NotifyContext
 func (s *PublicService) Start(ctx context.Context, errHandler ErrHandler) error {
+	end := s.client.ObserveSessionStarted(ctx, s.path)
+
 	done := make(chan struct{})
+	var runErr error
 
 	go func() {
 		defer close(done)
@@ -102,6 +155,9 @@ func (s *PublicService) Start(ctx context.Context, errHandler ErrHandler) error
 
 		for {
 			if err := s.Run(); err != nil {
+				if !ws.IsErrWebsocketClosed(err) {
+					runErr = err
+				}
 				errHandler(ws.IsErrWebsocketClosed(err), err)
 				return
 			}
@@ -111,27 +167,26 @@ func (s *PublicService) Start(ctx context.Context, errHandler ErrHandler) error
 	ticker := time.NewTicker(20 * time.Second)
 	defer ticker.Stop()
 
-	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
-	defer stop()
-
 	for {
 		select {
 		case <-done:
+			end(runErr)
 			return nil
 		case <-ticker.C:
 			if err := s.Ping(); err != nil {
+				end(err)
 				return err
 			}
 		case <-ctx.Done():
-			log.Println("interrupt")
-
 			if err := s.Close(); err != nil {
+				end(err)
 				return err
 			}
 			select {
 			case <-done:
 			case <-time.After(time.Second):
 			}
+			end(nil)
 			return nil
 		}
 	}
@@ -156,9 +211,10 @@ func (s *PublicService) Run() error {
 		}
 		f, err := s.retrieveOrderBookFunc(resp.Key())
 		if err != nil {
+			s.client.ObserveMessageDropped(s.path, resp.Topic, err.Error())
 			return err
 		}
-		if err := f(resp); err != nil {
+		if err := s.handle(resp.Topic, func() error { return f(resp) }); err != nil {
 			return err
 		}
 	case PublicTopicTickers:
@@ -168,24 +224,117 @@ func (s *PublicService) Run() error {
 		}
 		f, err := s.retrieveTickersFunc(resp.Key())
 		if err != nil {
+			s.client.ObserveMessageDropped(s.path, resp.Topic, err.Error())
+			log.Printf("Received unsubscribed message, topic: %s, message: %s", topic, message)
+			return nil
+		}
+		if err := s.handle(resp.Topic, func() error { return f(resp) }); err != nil {
+			return err
+		}
+	case PublicTopicTrade:
+		var resp PublicTradeResponse
+		if err := s.parseResponse(message, &resp); err != nil {
+			return err
+		}
+		f, err := s.retrieveTradeFunc(resp.Key())
+		if err != nil {
+			s.client.ObserveMessageDropped(s.path, resp.Topic, err.Error())
+			log.Printf("Received unsubscribed message, topic: %s, message: %s", topic, message)
+			return nil
+		}
+		if err := s.handle(resp.Topic, func() error { return f(resp) }); err != nil {
+			return err
+		}
+	case PublicTopicKline:
+		var resp PublicKlineResponse
+		if err := s.parseResponse(message, &resp); err != nil {
+			return err
+		}
+		f, err := s.retrieveKlineFunc(resp.Key())
+		if err != nil {
+			s.client.ObserveMessageDropped(s.path, resp.Topic, err.Error())
+			log.Printf("Received unsubscribed message, topic: %s, message: %s", topic, message)
+			return nil
+		}
+		if err := s.handle(resp.Topic, func() error { return f(resp) }); err != nil {
+			return err
+		}
+	case PublicTopicLiquidation:
+		var resp PublicLiquidationResponse
+		if err := s.parseResponse(message, &resp); err != nil {
+			return err
+		}
+		f, err := s.retrieveLiquidationFunc(resp.Key())
+		if err != nil {
+			s.client.ObserveMessageDropped(s.path, resp.Topic, err.Error())
 			log.Printf("Received unsubscribed message, topic: %s, message: %s", topic, message)
 			return nil
 		}
-		if err := f(resp); err != nil {
+		if err := s.handle(resp.Topic, func() error { return f(resp) }); err != nil {
+			return err
+		}
+	case PublicTopicLTKline:
+		var resp PublicLTKlineResponse
+		if err := s.parseResponse(message, &resp); err != nil {
+			return err
+		}
+		f, err := s.retrieveLTKlineFunc(resp.Key())
+		if err != nil {
+			s.client.ObserveMessageDropped(s.path, resp.Topic, err.Error())
+			log.Printf("Received unsubscribed message, topic: %s, message: %s", topic, message)
+			return nil
+		}
+		if err := s.handle(resp.Topic, func() error { return f(resp) }); err != nil {
+			return err
+		}
+	case PublicTopicLTTicker:
+		var resp PublicLTTickerResponse
+		if err := s.parseResponse(message, &resp); err != nil {
+			return err
+		}
+		f, err := s.retrieveLTTickerFunc(resp.Key())
+		if err != nil {
+			s.client.ObserveMessageDropped(s.path, resp.Topic, err.Error())
+			log.Printf("Received unsubscribed message, topic: %s, message: %s", topic, message)
+			return nil
+		}
+		if err := s.handle(resp.Topic, func() error { return f(resp) }); err != nil {
+			return err
+		}
+	case PublicTopicLTNav:
+		var resp PublicLTNavResponse
+		if err := s.parseResponse(message, &resp); err != nil {
+			return err
+		}
+		f, err := s.retrieveLTNavFunc(resp.Key())
+		if err != nil {
+			s.client.ObserveMessageDropped(s.path, resp.Topic, err.Error())
+			log.Printf("Received unsubscribed message, topic: %s, message: %s", topic, message)
+			return nil
+		}
+		if err := s.handle(resp.Topic, func() error { return f(resp) }); err != nil {
 			return err
 		}
 	default:
 		if topic != "" {
+			s.client.ObserveMessageDropped(s.path, string(topic), "unrecognized topic")
 			return fmt.Errorf("cannot recognize topic: %s", topic)
 		}
-
 	}
 	return nil
 }
 
+// handle : calls the handler f, reporting its latency to the observers
+func (s *PublicService) handle(topic string, f func() error) error {
+	start := time.Now()
+	err := f()
+	s.client.ObserveMessageHandled(s.path, topic, time.Since(start), err)
+	return err
+}
+
 // Ping :
 func (s *PublicService) Ping() error {
-	if err := s.connection.WriteMessage(websocket.PingMessage, nil); err != nil {
+	if err := s.writeMessage(websocket.PingMessage, nil); err != nil {
 		return err
 	}
 	return nil
@@ -193,7 +342,93 @@ func (s *PublicService) Ping() error {
 
 // Close :
 func (s *PublicService) Close() error {
-	if err := s.connection.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")); err != nil {
+	s.writeMu.Lock()
+	s.closing = true
+	s.writeMu.Unlock()
+
+	if err := s.writeMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Supervise : serves the connection like Start, but redials it with backoff whenever it drops,
+// subscribing again to every topic.
+// Returns once ctx is done, Close is called, or policy gives up.
+func (s *PublicService) Supervise(ctx context.Context, policy *ReconnectPolicy, stateHandler StateHandler) error {
+	return supervise(ctx, s.client, s.path, s, policy, stateHandler)
+}
+
+// writeMessage : one writer at a time, to the current connection
+func (s *PublicService) writeMessage(messageType int, data []byte) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	return s.connection.WriteMessage(messageType, data)
+}
+
+// conn :
+func (s *PublicService) conn() *websocket.Conn {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	return s.connection
+}
+
+// isClosing :
+func (s *PublicService) isClosing() bool {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	return s.closing
+}
+
+// redial : dials a new connection and subscribes to the registered topics on it
+func (s *PublicService) redial(ctx context.Context) error {
+	c, _, err := s.client.Dialer.DialContext(ctx, s.client.BaseURL+s.path, nil)
+	if err != nil {
+		return err
+	}
+	s.writeMu.Lock()
+	if s.closing {
+		s.writeMu.Unlock()
+		_ = c.Close()
+		return errServiceClosing
+	}
+	s.connection = c
+	s.writeMu.Unlock()
+
+	s.paramMu.Lock()
+	var topics []string
+	for key := range s.paramOrderBookMap {
+		topics = append(topics, key.Topic())
+	}
+	for key := range s.paramTickersMap {
+		topics = append(topics, key.Topic())
+	}
+	for key := range s.paramTradeMap {
+		topics = append(topics, key.Topic())
+	}
+	for key := range s.paramKlineMap {
+		topics = append(topics, key.Topic())
+	}
+	for key := range s.paramLiquidationMap {
+		topics = append(topics, key.Topic())
+	}
+	for key := range s.paramLTKlineMap {
+		topics = append(topics, key.Topic())
+	}
+	for key := range s.paramLTTickerMap {
+		topics = append(topics, key.Topic())
+	}
+	for key := range s.paramLTNavMap {
+		topics = append(topics, key.Topic())
+	}
+	s.paramMu.Unlock()
+
+	sort.Strings(topics)
+	if err := subscribeAll(s.writeMessage, topics); err != nil {
+		_ = c.Close()
 		return err
 	}
 	return nil
@@ -0,0 +1,132 @@
+package wsv5
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gorilla/websocket"
+	"github.com/sngyai/go-bybit"
+)
+
+// SubscribeKline :
+func (s *PublicService) SubscribeKline(
+	key PublicKlineParamKey,
+	f func(PublicKlineResponse) error,
+) (func() error, error) {
+	if err := s.addParamKlineFunc(key, f); err != nil {
+		return nil, err
+	}
+	param := struct {
+		Op   string        `json:"op"`
+		Args []interface{} `json:"args"`
+	}{
+		Op:   "subscribe",
+		Args: []interface{}{key.Topic()},
+	}
+	buf, err := json.Marshal(param)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.writeMessage(websocket.TextMessage, buf); err != nil {
+		return nil, err
+	}
+	return func() error {
+		param := struct {
+			Op   string        `json:"op"`
+			Args []interface{} `json:"args"`
+		}{
+			Op:   "unsubscribe",
+			Args: []interface{}{key.Topic()},
+		}
+		buf, err := json.Marshal(param)
+		if err != nil {
+			return err
+		}
+		if err := s.writeMessage(websocket.TextMessage, buf); err != nil {
+			return err
+		}
+		s.removeParamKlineFunc(key)
+		return nil
+	}, nil
+}
+
+// PublicKlineParamKey :
+type PublicKlineParamKey struct {
+	Interval bybit.Interval
+	Symbol   bybit.SymbolV5
+}
+
+// Topic :
+func (k *PublicKlineParamKey) Topic() string {
+	return fmt.Sprintf("%s.%s.%s", PublicTopicKline, k.Interval, k.Symbol)
+}
+
+// PublicKlineResponse :
+type PublicKlineResponse struct {
+	Topic     string            `json:"topic"`
+	Type      string            `json:"type"`
+	TimeStamp int64             `json:"ts"`
+	Data      []PublicKlineData `json:"data"`
+}
+
+// PublicKlineData :
+type PublicKlineData struct {
+	Start     int64          `json:"start"`
+	End       int64          `json:"end"`
+	Interval  bybit.Interval `json:"interval"`
+	Open      bybit.Decimal  `json:"open"`
+	Close     bybit.Decimal  `json:"close"`
+	High      bybit.Decimal  `json:"high"`
+	Low       bybit.Decimal  `json:"low"`
+	Volume    bybit.Decimal  `json:"volume"`
+	Turnover  bybit.Decimal  `json:"turnover"`
+	Confirm   bool           `json:"confirm"`
+	Timestamp int64          `json:"timestamp"`
+}
+
+// Key :
+func (r *PublicKlineResponse) Key() PublicKlineParamKey {
+	topic := r.Topic
+	arr := strings.Split(topic, ".")
+	if arr[0] != PublicTopicKline || len(arr) != 3 {
+		return PublicKlineParamKey{}
+	}
+	return PublicKlineParamKey{
+		Interval: bybit.Interval(arr[1]),
+		Symbol:   bybit.SymbolV5(arr[2]),
+	}
+}
+
+// addParamKlineFunc :
+func (s *PublicService) addParamKlineFunc(param PublicKlineParamKey, f func(PublicKlineResponse) error) error {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
+	if _, exist := s.paramKlineMap[param]; exist {
+		return errors.New("already registered for this param")
+	}
+	s.paramKlineMap[param] = f
+	return nil
+}
+
+// removeParamKlineFunc :
+func (s *PublicService) removeParamKlineFunc(key PublicKlineParamKey) {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
+	delete(s.paramKlineMap, key)
+}
+
+// retrieveKlineFunc :
+func (s *PublicService) retrieveKlineFunc(key PublicKlineParamKey) (func(PublicKlineResponse) error, error) {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
+	f, exist := s.paramKlineMap[key]
+	if !exist {
+		return nil, errors.New("kline func not found")
+	}
+	return f, nil
+}
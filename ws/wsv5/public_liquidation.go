@@ -0,0 +1,125 @@
+package wsv5
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gorilla/websocket"
+	"github.com/sngyai/go-bybit"
+)
+
+// SubscribeLiquidation :
+func (s *PublicService) SubscribeLiquidation(
+	key PublicLiquidationParamKey,
+	f func(PublicLiquidationResponse) error,
+) (func() error, error) {
+	if err := s.addParamLiquidationFunc(key, f); err != nil {
+		return nil, err
+	}
+	param := struct {
+		Op   string        `json:"op"`
+		Args []interface{} `json:"args"`
+	}{
+		Op:   "subscribe",
+		Args: []interface{}{key.Topic()},
+	}
+	buf, err := json.Marshal(param)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.writeMessage(websocket.TextMessage, buf); err != nil {
+		return nil, err
+	}
+	return func() error {
+		param := struct {
+			Op   string        `json:"op"`
+			Args []interface{} `json:"args"`
+		}{
+			Op:   "unsubscribe",
+			Args: []interface{}{key.Topic()},
+		}
+		buf, err := json.Marshal(param)
+		if err != nil {
+			return err
+		}
+		if err := s.writeMessage(websocket.TextMessage, buf); err != nil {
+			return err
+		}
+		s.removeParamLiquidationFunc(key)
+		return nil
+	}, nil
+}
+
+// PublicLiquidationParamKey :
+type PublicLiquidationParamKey struct {
+	Symbol bybit.SymbolV5
+}
+
+// Topic :
+func (k *PublicLiquidationParamKey) Topic() string {
+	return fmt.Sprintf("%s.%s", PublicTopicLiquidation, k.Symbol)
+}
+
+// PublicLiquidationResponse :
+type PublicLiquidationResponse struct {
+	Topic     string                `json:"topic"`
+	Type      string                `json:"type"`
+	TimeStamp int64                 `json:"ts"`
+	Data      PublicLiquidationData `json:"data"`
+}
+
+// PublicLiquidationData :
+type PublicLiquidationData struct {
+	UpdatedTime int64          `json:"updatedTime"`
+	Symbol      bybit.SymbolV5 `json:"symbol"`
+	Side        bybit.Side     `json:"side"`
+	Size        bybit.Decimal  `json:"size"`
+	Price       bybit.Decimal  `json:"price"`
+}
+
+// Key :
+func (r *PublicLiquidationResponse) Key() PublicLiquidationParamKey {
+	topic := r.Topic
+	arr := strings.Split(topic, ".")
+	if arr[0] != PublicTopicLiquidation || len(arr) != 2 {
+		return PublicLiquidationParamKey{}
+	}
+	symbol := bybit.SymbolV5(arr[1])
+	return PublicLiquidationParamKey{
+		Symbol: symbol,
+	}
+}
+
+// addParamLiquidationFunc :
+func (s *PublicService) addParamLiquidationFunc(param PublicLiquidationParamKey, f func(PublicLiquidationResponse) error) error {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
+	if _, exist := s.paramLiquidationMap[param]; exist {
+		return errors.New("already registered for this param")
+	}
+	s.paramLiquidationMap[param] = f
+	return nil
+}
+
+// removeParamLiquidationFunc :
+func (s *PublicService) removeParamLiquidationFunc(key PublicLiquidationParamKey) {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
+	delete(s.paramLiquidationMap, key)
+}
+
+// retrieveLiquidationFunc :
+func (s *PublicService) retrieveLiquidationFunc(key PublicLiquidationParamKey) (func(PublicLiquidationResponse) error, error) {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
+	f, exist := s.paramLiquidationMap[key]
+	if !exist {
+		return nil, errors.New("liquidation func not found")
+	}
+	return f, nil
+}
@@ -0,0 +1,130 @@
+package wsv5
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gorilla/websocket"
+	"github.com/sngyai/go-bybit"
+)
+
+// SubscribeLTKline :
+func (s *PublicService) SubscribeLTKline(
+	key PublicLTKlineParamKey,
+	f func(PublicLTKlineResponse) error,
+) (func() error, error) {
+	if err := s.addParamLTKlineFunc(key, f); err != nil {
+		return nil, err
+	}
+	param := struct {
+		Op   string        `json:"op"`
+		Args []interface{} `json:"args"`
+	}{
+		Op:   "subscribe",
+		Args: []interface{}{key.Topic()},
+	}
+	buf, err := json.Marshal(param)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.writeMessage(websocket.TextMessage, buf); err != nil {
+		return nil, err
+	}
+	return func() error {
+		param := struct {
+			Op   string        `json:"op"`
+			Args []interface{} `json:"args"`
+		}{
+			Op:   "unsubscribe",
+			Args: []interface{}{key.Topic()},
+		}
+		buf, err := json.Marshal(param)
+		if err != nil {
+			return err
+		}
+		if err := s.writeMessage(websocket.TextMessage, buf); err != nil {
+			return err
+		}
+		s.removeParamLTKlineFunc(key)
+		return nil
+	}, nil
+}
+
+// PublicLTKlineParamKey :
+type PublicLTKlineParamKey struct {
+	Interval bybit.Interval
+	Symbol   bybit.SymbolV5
+}
+
+// Topic :
+func (k *PublicLTKlineParamKey) Topic() string {
+	return fmt.Sprintf("%s.%s.%s", PublicTopicLTKline, k.Interval, k.Symbol)
+}
+
+// PublicLTKlineResponse :
+type PublicLTKlineResponse struct {
+	Topic     string              `json:"topic"`
+	Type      string              `json:"type"`
+	TimeStamp int64               `json:"ts"`
+	Data      []PublicLTKlineData `json:"data"`
+}
+
+// PublicLTKlineData :
+type PublicLTKlineData struct {
+	Start     int64          `json:"start"`
+	End       int64          `json:"end"`
+	Interval  bybit.Interval `json:"interval"`
+	Open      bybit.Decimal  `json:"open"`
+	Close     bybit.Decimal  `json:"close"`
+	High      bybit.Decimal  `json:"high"`
+	Low       bybit.Decimal  `json:"low"`
+	Confirm   bool           `json:"confirm"`
+	Timestamp int64          `json:"timestamp"`
+}
+
+// Key :
+func (r *PublicLTKlineResponse) Key() PublicLTKlineParamKey {
+	topic := r.Topic
+	arr := strings.Split(topic, ".")
+	if arr[0] != PublicTopicLTKline || len(arr) != 3 {
+		return PublicLTKlineParamKey{}
+	}
+	return PublicLTKlineParamKey{
+		Interval: bybit.Interval(arr[1]),
+		Symbol:   bybit.SymbolV5(arr[2]),
+	}
+}
+
+// addParamLTKlineFunc :
+func (s *PublicService) addParamLTKlineFunc(param PublicLTKlineParamKey, f func(PublicLTKlineResponse) error) error {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
+	if _, exist := s.paramLTKlineMap[param]; exist {
+		return errors.New("already registered for this param")
+	}
+	s.paramLTKlineMap[param] = f
+	return nil
+}
+
+// removeParamLTKlineFunc :
+func (s *PublicService) removeParamLTKlineFunc(key PublicLTKlineParamKey) {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
+	delete(s.paramLTKlineMap, key)
+}
+
+// retrieveLTKlineFunc :
+func (s *PublicService) retrieveLTKlineFunc(key PublicLTKlineParamKey) (func(PublicLTKlineResponse) error, error) {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
+	f, exist := s.paramLTKlineMap[key]
+	if !exist {
+		return nil, errors.New("lt kline func not found")
+	}
+	return f, nil
+}
@@ -0,0 +1,128 @@
+package wsv5
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gorilla/websocket"
+	"github.com/sngyai/go-bybit"
+)
+
+// SubscribeLTNav :
+func (s *PublicService) SubscribeLTNav(
+	key PublicLTNavParamKey,
+	f func(PublicLTNavResponse) error,
+) (func() error, error) {
+	if err := s.addParamLTNavFunc(key, f); err != nil {
+		return nil, err
+	}
+	param := struct {
+		Op   string        `json:"op"`
+		Args []interface{} `json:"args"`
+	}{
+		Op:   "subscribe",
+		Args: []interface{}{key.Topic()},
+	}
+	buf, err := json.Marshal(param)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.writeMessage(websocket.TextMessage, buf); err != nil {
+		return nil, err
+	}
+	return func() error {
+		param := struct {
+			Op   string        `json:"op"`
+			Args []interface{} `json:"args"`
+		}{
+			Op:   "unsubscribe",
+			Args: []interface{}{key.Topic()},
+		}
+		buf, err := json.Marshal(param)
+		if err != nil {
+			return err
+		}
+		if err := s.writeMessage(websocket.TextMessage, buf); err != nil {
+			return err
+		}
+		s.removeParamLTNavFunc(key)
+		return nil
+	}, nil
+}
+
+// PublicLTNavParamKey :
+type PublicLTNavParamKey struct {
+	Symbol bybit.SymbolV5
+}
+
+// Topic :
+func (k *PublicLTNavParamKey) Topic() string {
+	return fmt.Sprintf("%s.%s", PublicTopicLTNav, k.Symbol)
+}
+
+// PublicLTNavResponse :
+type PublicLTNavResponse struct {
+	Topic     string          `json:"topic"`
+	Type      string          `json:"type"`
+	TimeStamp int64           `json:"ts"`
+	Data      PublicLTNavData `json:"data"`
+}
+
+// PublicLTNavData :
+type PublicLTNavData struct {
+	Time           int64          `json:"time"`
+	Symbol         bybit.SymbolV5 `json:"symbol"`
+	Nav            bybit.Decimal  `json:"nav"`
+	BasketPosition bybit.Decimal  `json:"basketPosition"`
+	Leverage       bybit.Decimal  `json:"leverage"`
+	BasketLoan     bybit.Decimal  `json:"basketLoan"`
+	Circulation    bybit.Decimal  `json:"circulation"`
+	Basket         bybit.Decimal  `json:"basket"`
+}
+
+// Key :
+func (r *PublicLTNavResponse) Key() PublicLTNavParamKey {
+	topic := r.Topic
+	arr := strings.Split(topic, ".")
+	if arr[0] != PublicTopicLTNav || len(arr) != 2 {
+		return PublicLTNavParamKey{}
+	}
+	symbol := bybit.SymbolV5(arr[1])
+	return PublicLTNavParamKey{
+		Symbol: symbol,
+	}
+}
+
+// addParamLTNavFunc :
+func (s *PublicService) addParamLTNavFunc(param PublicLTNavParamKey, f func(PublicLTNavResponse) error) error {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
+	if _, exist := s.paramLTNavMap[param]; exist {
+		return errors.New("already registered for this param")
+	}
+	s.paramLTNavMap[param] = f
+	return nil
+}
+
+// removeParamLTNavFunc :
+func (s *PublicService) removeParamLTNavFunc(key PublicLTNavParamKey) {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
+	delete(s.paramLTNavMap, key)
+}
+
+// retrieveLTNavFunc :
+func (s *PublicService) retrieveLTNavFunc(key PublicLTNavParamKey) (func(PublicLTNavResponse) error, error) {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
+	f, exist := s.paramLTNavMap[key]
+	if !exist {
+		return nil, errors.New("lt nav func not found")
+	}
+	return f, nil
+}
@@ -0,0 +1,126 @@
+package wsv5
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gorilla/websocket"
+	"github.com/sngyai/go-bybit"
+)
+
+// SubscribeLTTicker :
+func (s *PublicService) SubscribeLTTicker(
+	key PublicLTTickerParamKey,
+	f func(PublicLTTickerResponse) error,
+) (func() error, error) {
+	if err := s.addParamLTTickerFunc(key, f); err != nil {
+		return nil, err
+	}
+	param := struct {
+		Op   string        `json:"op"`
+		Args []interface{} `json:"args"`
+	}{
+		Op:   "subscribe",
+		Args: []interface{}{key.Topic()},
+	}
+	buf, err := json.Marshal(param)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.writeMessage(websocket.TextMessage, buf); err != nil {
+		return nil, err
+	}
+	return func() error {
+		param := struct {
+			Op   string        `json:"op"`
+			Args []interface{} `json:"args"`
+		}{
+			Op:   "unsubscribe",
+			Args: []interface{}{key.Topic()},
+		}
+		buf, err := json.Marshal(param)
+		if err != nil {
+			return err
+		}
+		if err := s.writeMessage(websocket.TextMessage, buf); err != nil {
+			return err
+		}
+		s.removeParamLTTickerFunc(key)
+		return nil
+	}, nil
+}
+
+// PublicLTTickerParamKey :
+type PublicLTTickerParamKey struct {
+	Symbol bybit.SymbolV5
+}
+
+// Topic :
+func (k *PublicLTTickerParamKey) Topic() string {
+	return fmt.Sprintf("%s.%s", PublicTopicLTTicker, k.Symbol)
+}
+
+// PublicLTTickerResponse :
+type PublicLTTickerResponse struct {
+	Topic     string             `json:"topic"`
+	Type      string             `json:"type"`
+	TimeStamp int64              `json:"ts"`
+	Data      PublicLTTickerData `json:"data"`
+}
+
+// PublicLTTickerData :
+type PublicLTTickerData struct {
+	Symbol       bybit.SymbolV5 `json:"symbol"`
+	Price24HPcnt bybit.Decimal  `json:"price24hPcnt"`
+	LastPrice    bybit.Decimal  `json:"lastPrice"`
+	PrevPrice24H bybit.Decimal  `json:"prevPrice24h"`
+	HighPrice24H bybit.Decimal  `json:"highPrice24h"`
+	LowPrice24H  bybit.Decimal  `json:"lowPrice24h"`
+}
+
+// Key :
+func (r *PublicLTTickerResponse) Key() PublicLTTickerParamKey {
+	topic := r.Topic
+	arr := strings.Split(topic, ".")
+	if arr[0] != PublicTopicLTTicker || len(arr) != 2 {
+		return PublicLTTickerParamKey{}
+	}
+	symbol := bybit.SymbolV5(arr[1])
+	return PublicLTTickerParamKey{
+		Symbol: symbol,
+	}
+}
+
+// addParamLTTickerFunc :
+func (s *PublicService) addParamLTTickerFunc(param PublicLTTickerParamKey, f func(PublicLTTickerResponse) error) error {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
+	if _, exist := s.paramLTTickerMap[param]; exist {
+		return errors.New("already registered for this param")
+	}
+	s.paramLTTickerMap[param] = f
+	return nil
+}
+
+// removeParamLTTickerFunc :
+func (s *PublicService) removeParamLTTickerFunc(key PublicLTTickerParamKey) {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
+	delete(s.paramLTTickerMap, key)
+}
+
+// retrieveLTTickerFunc :
+func (s *PublicService) retrieveLTTickerFunc(key PublicLTTickerParamKey) (func(PublicLTTickerResponse) error, error) {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
+	f, exist := s.paramLTTickerMap[key]
+	if !exist {
+		return nil, errors.New("lt ticker func not found")
+	}
+	return f, nil
+}
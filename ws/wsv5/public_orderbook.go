@@ -30,7 +30,7 @@ func (s *PublicService) SubscribeOrderBook(
 	if err != nil {
 		return nil, err
 	}
-	if err := s.connection.WriteMessage(websocket.TextMessage, buf); err != nil {
+	if err := s.writeMessage(websocket.TextMessage, buf); err != nil {
 		return nil, err
 	}
 	return func() error {
@@ -45,7 +45,7 @@ func (s *PublicService) SubscribeOrderBook(
 		if err != nil {
 			return err
 		}
-		if err := s.connection.WriteMessage(websocket.TextMessage, buf); err != nil {
+		if err := s.writeMessage(websocket.TextMessage, buf); err != nil {
 			return err
 		}
 		s.removeParamOrderBookFunc(key)
@@ -53,6 +53,28 @@ func (s *PublicService) SubscribeOrderBook(
 	}, nil
 }
 
+// ResubscribeOrderBook : subscribes again to key, which the stream answers with a fresh snapshot.
+// The handler stays registered
+func (s *PublicService) ResubscribeOrderBook(key PublicOrderBookParamKey) error {
+	for _, op := range []string{"unsubscribe", "subscribe"} {
+		param := struct {
+			Op   string        `json:"op"`
+			Args []interface{} `json:"args"`
+		}{
+			Op:   op,
+			Args: []interface{}{key.Topic()},
+		}
+		buf, err := json.Marshal(param)
+		if err != nil {
+			return err
+		}
+		if err := s.writeMessage(websocket.TextMessage, buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // PublicOrderBookParamKey :
 type PublicOrderBookParamKey struct {
 	Depth  int
@@ -83,13 +105,13 @@ type PublicOrderBookData struct {
 
 // PublicOrderBookBids :
 type PublicOrderBookBids []struct {
-	Price string `json:"price"`
-	Size  string `json:"size"`
+	Price bybit.Decimal `json:"price"`
+	Size  bybit.Decimal `json:"size"`
 }
 
 // UnmarshalJSON :
 func (b *PublicOrderBookBids) UnmarshalJSON(data []byte) error {
-	parsedData := [][]string{}
+	parsedData := [][]bybit.Decimal{}
 	if err := json.Unmarshal(data, &parsedData); err != nil {
 		return err
 	}
@@ -108,13 +130,13 @@ func (b *PublicOrderBookBids) UnmarshalJSON(data []byte) error {
 
 // PublicOrderBookAsks :
 type PublicOrderBookAsks []struct {
-	Price string `json:"price"`
-	Size  string `json:"size"`
+	Price bybit.Decimal `json:"price"`
+	Size  bybit.Decimal `json:"size"`
 }
 
 // UnmarshalJSON :
 func (b *PublicOrderBookAsks) UnmarshalJSON(data []byte) error {
-	parsedData := [][]string{}
+	parsedData := [][]bybit.Decimal{}
 	if err := json.Unmarshal(data, &parsedData); err != nil {
 		return err
 	}
@@ -151,6 +173,9 @@ func (r *PublicOrderBookResponse) Key() PublicOrderBookParamKey {
 
 // addParamOrderBookFunc :
 func (s *PublicService) addParamOrderBookFunc(param PublicOrderBookParamKey, f func(PublicOrderBookResponse) error) error {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
 	if _, exist := s.paramOrderBookMap[param]; exist {
 		return errors.New("already registered for this param")
 	}
@@ -160,11 +185,17 @@ func (s *PublicService) addParamOrderBookFunc(param PublicOrderBookParamKey, f f
 
 // removeParamTradeFunc :
 func (s *PublicService) removeParamOrderBookFunc(key PublicOrderBookParamKey) {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
 	delete(s.paramOrderBookMap, key)
 }
 
 // retrievePositionFunc :
 func (s *PublicService) retrieveOrderBookFunc(key PublicOrderBookParamKey) (func(PublicOrderBookResponse) error, error) {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
 	f, exist := s.paramOrderBookMap[key]
 	if !exist {
 		return nil, errors.New("orderbook func not found")
@@ -29,7 +29,7 @@ func (s *PublicService) SubscribeTickers(
 	if err != nil {
 		return nil, err
 	}
-	if err := s.connection.WriteMessage(websocket.TextMessage, buf); err != nil {
+	if err := s.writeMessage(websocket.TextMessage, buf); err != nil {
 		return nil, err
 	}
 	return func() error {
@@ -44,7 +44,7 @@ func (s *PublicService) SubscribeTickers(
 		if err != nil {
 			return err
 		}
-		if err := s.connection.WriteMessage(websocket.TextMessage, buf); err != nil {
+		if err := s.writeMessage(websocket.TextMessage, buf); err != nil {
 			return err
 		}
 		s.removeParamTickersFunc(key)
@@ -72,15 +72,15 @@ type PublicTickersResponse struct {
 
 // PublicTickersData :
 type PublicTickersData struct {
-	Symbol        string `json:"symbol"`
-	LastPrice     string `json:"lastPrice"`
-	HighPrice24H  string `json:"highPrice24h"`
-	LowPrice24H   string `json:"lowPrice24h"`
-	PrevPrice24H  string `json:"prevPrice24h"`
-	Volume24H     string `json:"volume24h"`
-	Turnover24H   string `json:"turnover24h"`
-	Price24HPcnt  string `json:"price24hPcnt"`
-	UsdIndexPrice string `json:"usdIndexPrice"`
+	Symbol        string        `json:"symbol"`
+	LastPrice     bybit.Decimal `json:"lastPrice"`
+	HighPrice24H  bybit.Decimal `json:"highPrice24h"`
+	LowPrice24H   bybit.Decimal `json:"lowPrice24h"`
+	PrevPrice24H  bybit.Decimal `json:"prevPrice24h"`
+	Volume24H     bybit.Decimal `json:"volume24h"`
+	Turnover24H   bybit.Decimal `json:"turnover24h"`
+	Price24HPcnt  bybit.Decimal `json:"price24hPcnt"`
+	UsdIndexPrice bybit.Decimal `json:"usdIndexPrice"`
 }
 
 // Key :
@@ -98,6 +98,9 @@ func (r *PublicTickersResponse) Key() PublicTickersParamKey {
 
 // addParamTickersFunc :
 func (s *PublicService) addParamTickersFunc(param PublicTickersParamKey, f func(PublicTickersResponse) error) error {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
 	if _, exist := s.paramTickersMap[param]; exist {
 		return errors.New("already registered for this param")
 	}
@@ -107,11 +110,17 @@ func (s *PublicService) addParamTickersFunc(param PublicTickersParamKey, f func(
 
 // removeParamTradeFunc :
 func (s *PublicService) removeParamTickersFunc(key PublicTickersParamKey) {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
 	delete(s.paramTickersMap, key)
 }
 
 // retrievePositionFunc :
 func (s *PublicService) retrieveTickersFunc(key PublicTickersParamKey) (func(PublicTickersResponse) error, error) {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
 	f, exist := s.paramTickersMap[key]
 	if !exist {
 		return nil, errors.New("tickers func not found")
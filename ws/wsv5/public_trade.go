@@ -0,0 +1,128 @@
+package wsv5
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gorilla/websocket"
+	"github.com/sngyai/go-bybit"
+)
+
+// SubscribeTrade :
+func (s *PublicService) SubscribeTrade(
+	key PublicTradeParamKey,
+	f func(PublicTradeResponse) error,
+) (func() error, error) {
+	if err := s.addParamTradeFunc(key, f); err != nil {
+		return nil, err
+	}
+	param := struct {
+		Op   string        `json:"op"`
+		Args []interface{} `json:"args"`
+	}{
+		Op:   "subscribe",
+		Args: []interface{}{key.Topic()},
+	}
+	buf, err := json.Marshal(param)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.writeMessage(websocket.TextMessage, buf); err != nil {
+		return nil, err
+	}
+	return func() error {
+		param := struct {
+			Op   string        `json:"op"`
+			Args []interface{} `json:"args"`
+		}{
+			Op:   "unsubscribe",
+			Args: []interface{}{key.Topic()},
+		}
+		buf, err := json.Marshal(param)
+		if err != nil {
+			return err
+		}
+		if err := s.writeMessage(websocket.TextMessage, buf); err != nil {
+			return err
+		}
+		s.removeParamTradeFunc(key)
+		return nil
+	}, nil
+}
+
+// PublicTradeParamKey :
+type PublicTradeParamKey struct {
+	Symbol bybit.SymbolV5
+}
+
+// Topic :
+func (k *PublicTradeParamKey) Topic() string {
+	return fmt.Sprintf("%s.%s", PublicTopicTrade, k.Symbol)
+}
+
+// PublicTradeResponse :
+type PublicTradeResponse struct {
+	Topic     string            `json:"topic"`
+	Type      string            `json:"type"`
+	TimeStamp int64             `json:"ts"`
+	Data      []PublicTradeData `json:"data"`
+}
+
+// PublicTradeData :
+type PublicTradeData struct {
+	Timestamp     int64          `json:"T"`
+	Symbol        bybit.SymbolV5 `json:"s"`
+	Side          bybit.Side     `json:"S"`
+	Size          bybit.Decimal  `json:"v"`
+	Price         bybit.Decimal  `json:"p"`
+	TickDirection string         `json:"L"`
+	TradeID       string         `json:"i"`
+	BlockTrade    bool           `json:"BT"`
+}
+
+// Key :
+func (r *PublicTradeResponse) Key() PublicTradeParamKey {
+	topic := r.Topic
+	arr := strings.Split(topic, ".")
+	if arr[0] != PublicTopicTrade || len(arr) != 2 {
+		return PublicTradeParamKey{}
+	}
+	symbol := bybit.SymbolV5(arr[1])
+	return PublicTradeParamKey{
+		Symbol: symbol,
+	}
+}
+
+// addParamTradeFunc :
+func (s *PublicService) addParamTradeFunc(param PublicTradeParamKey, f func(PublicTradeResponse) error) error {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
+	if _, exist := s.paramTradeMap[param]; exist {
+		return errors.New("already registered for this param")
+	}
+	s.paramTradeMap[param] = f
+	return nil
+}
+
+// removeParamTradeFunc :
+func (s *PublicService) removeParamTradeFunc(key PublicTradeParamKey) {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
+	delete(s.paramTradeMap, key)
+}
+
+// retrieveTradeFunc :
+func (s *PublicService) retrieveTradeFunc(key PublicTradeParamKey) (func(PublicTradeResponse) error, error) {
+	s.paramMu.Lock()
+	defer s.paramMu.Unlock()
+
+	f, exist := s.paramTradeMap[key]
+	if !exist {
+		return nil, errors.New("trade func not found")
+	}
+	return f, nil
+}
@@ -0,0 +1,226 @@
+package wsv5
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/sngyai/go-bybit/ws"
+)
+
+// ReconnectPolicy : redials a dropped connection with exponential backoff and full jitter
+type ReconnectPolicy struct {
+	MaxAttempts int           // Consecutive failed attempts before giving up, 0 for no limit
+	BaseDelay   time.Duration // Backoff before the first attempt, doubled on each further attempt
+	MaxDelay    time.Duration // Upper bound of the backoff
+}
+
+// DefaultReconnectPolicy :
+func DefaultReconnectPolicy() *ReconnectPolicy {
+	return &ReconnectPolicy{
+		MaxAttempts: 0,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    30 * time.Second,
+	}
+}
+
+func (p *ReconnectPolicy) backoff(attempt int) time.Duration {
+	d := p.BaseDelay << (attempt - 1)
+	if d <= 0 || d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	if d <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(d) + 1))
+}
+
+// ConnectionState :
+type ConnectionState string
+
+const (
+	// ConnectionStateConnected : serving the connection, with every subscription applied
+	ConnectionStateConnected = ConnectionState("Connected")
+	// ConnectionStateDisconnected : the connection dropped, with the error it dropped with
+	ConnectionStateDisconnected = ConnectionState("Disconnected")
+	// ConnectionStateReconnecting : before each attempt, with the error of the previous one
+	ConnectionStateReconnecting = ConnectionState("Reconnecting")
+	// ConnectionStateClosed : Supervise returned, with the error it returned
+	ConnectionStateClosed = ConnectionState("Closed")
+)
+
+// ErrReconnectAttemptsExhausted : returned by Supervise once ReconnectPolicy.MaxAttempts consecutive attempts failed
+var ErrReconnectAttemptsExhausted = errors.New("reconnect attempts exhausted")
+
+// errServiceClosing : redial of a service being closed
+var errServiceClosing = errors.New("service is closing")
+
+// StateHandler : called on each connection state transition of Supervise
+type StateHandler func(state ConnectionState, err error)
+
+// maxSubscribeArgs : the spot stream accepts at most 10 args per subscribe request
+const maxSubscribeArgs = 10
+
+// supervisedService :
+type supervisedService interface {
+	Run() error
+	Ping() error
+	Close() error
+
+	// conn : the connection being served
+	conn() *websocket.Conn
+	// redial : replaces the connection, then authenticates it and subscribes again
+	redial(ctx context.Context) error
+	// isClosing : whether Close was called, so that the connection is not redialed
+	isClosing() bool
+}
+
+// supervise : serves svc, redialing it according to policy whenever its connection drops
+func supervise(ctx context.Context, client *ws.WebSocketClient, path string, svc supervisedService, policy *ReconnectPolicy, stateHandler StateHandler) error {
+	if policy == nil {
+		policy = DefaultReconnectPolicy()
+	}
+	notify := func(state ConnectionState, err error) {
+		if stateHandler != nil {
+			stateHandler(state, err)
+		}
+	}
+
+	notify(ConnectionStateConnected, nil)
+	attempt := 0
+	for {
+		received, err := serveSession(ctx, client, path, svc)
+		if ctx.Err() != nil || svc.isClosing() {
+			notify(ConnectionStateClosed, nil)
+			return nil
+		}
+		notify(ConnectionStateDisconnected, err)
+
+		// a session failing before its first message, e.g. on auth, counts as a failed attempt
+		if received {
+			attempt = 0
+		}
+		for {
+			attempt++
+			if policy.MaxAttempts > 0 && attempt > policy.MaxAttempts {
+				if err == nil {
+					err = ErrReconnectAttemptsExhausted
+				} else {
+					err = fmt.Errorf("%w: %v", ErrReconnectAttemptsExhausted, err)
+				}
+				notify(ConnectionStateClosed, err)
+				return err
+			}
+			notify(ConnectionStateReconnecting, err)
+
+			timer := time.NewTimer(policy.backoff(attempt))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				notify(ConnectionStateClosed, nil)
+				return nil
+			case <-timer.C:
+			}
+
+			if err = svc.redial(ctx); err == nil {
+				break
+			}
+			if svc.isClosing() {
+				notify(ConnectionStateClosed, nil)
+				return nil
+			}
+		}
+		client.ObserveReconnected(path)
+		notify(ConnectionStateConnected, nil)
+	}
+}
+
+// serveSession : reads and pings the current connection of svc until it drops or ctx is done.
+// received reports whether any message was handled
+func serveSession(ctx context.Context, client *ws.WebSocketClient, path string, svc supervisedService) (received bool, err error) {
+	end := client.ObserveSessionStarted(ctx, path)
+	defer func() { end(err) }()
+
+	connection := svc.conn()
+	done := make(chan struct{})
+	var runErr error
+
+	go func() {
+		defer close(done)
+		defer connection.Close()
+		_ = connection.SetReadDeadline(time.Now().Add(60 * time.Second))
+		connection.SetPongHandler(func(string) error {
+			_ = connection.SetReadDeadline(time.Now().Add(60 * time.Second))
+			return nil
+		})
+
+		for {
+			if err := svc.Run(); err != nil {
+				if !ws.IsErrWebsocketClosed(err) {
+					runErr = err
+				}
+				return
+			}
+			received = true
+		}
+	}()
+
+	ticker := time.NewTicker(20 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return received, runErr
+		case <-ticker.C:
+			if err := svc.Ping(); err != nil {
+				_ = connection.Close()
+				<-done
+				return received, err
+			}
+		case <-ctx.Done():
+			_ = svc.Close()
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+				_ = connection.Close()
+				<-done
+			}
+			return received, nil
+		}
+	}
+}
+
+// subscribeAll : subscribes to topics on a freshly dialed connection
+func subscribeAll(write func(int, []byte) error, topics []string) error {
+	for len(topics) > 0 {
+		n := len(topics)
+		if n > maxSubscribeArgs {
+			n = maxSubscribeArgs
+		}
+		args := make([]interface{}, 0, n)
+		for _, topic := range topics[:n] {
+			args = append(args, topic)
+		}
+		param := struct {
+			Op   string        `json:"op"`
+			Args []interface{} `json:"args"`
+		}{
+			Op:   "subscribe",
+			Args: args,
+		}
+		buf, err := json.Marshal(param)
+		if err != nil {
+			return err
+		}
+		if err := write(websocket.TextMessage, buf); err != nil {
+			return err
+		}
+		topics = topics[n:]
+	}
+	return nil
+}
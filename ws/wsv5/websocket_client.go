@@ -23,29 +23,36 @@ type V5WebsocketServiceI interface {
 
 // Public :
 func (s *WebsocketClientV5) Public(category bybit.CategoryV5) (PublicServiceI, error) {
-	url := s.Client.BaseURL + PublicPathFor(category)
-	c, _, err := s.Client.Dialer.Dial(url, nil)
+	path := PublicPathFor(category)
+	c, _, err := s.Client.Dialer.Dial(s.Client.BaseURL+path, nil)
 	if err != nil {
 		return nil, err
 	}
 	return &PublicService{
-		client:            s.Client,
-		connection:        c,
-		paramOrderBookMap: map[PublicOrderBookParamKey]func(PublicOrderBookResponse) error{},
-		paramTickersMap:   map[PublicTickersParamKey]func(PublicTickersResponse) error{},
+		client:              s.Client,
+		connection:          c,
+		path:                path,
+		paramOrderBookMap:   map[PublicOrderBookParamKey]func(PublicOrderBookResponse) error{},
+		paramTickersMap:     map[PublicTickersParamKey]func(PublicTickersResponse) error{},
+		paramTradeMap:       map[PublicTradeParamKey]func(PublicTradeResponse) error{},
+		paramKlineMap:       map[PublicKlineParamKey]func(PublicKlineResponse) error{},
+		paramLiquidationMap: map[PublicLiquidationParamKey]func(PublicLiquidationResponse) error{},
+		paramLTKlineMap:     map[PublicLTKlineParamKey]func(PublicLTKlineResponse) error{},
+		paramLTTickerMap:    map[PublicLTTickerParamKey]func(PublicLTTickerResponse) error{},
+		paramLTNavMap:       map[PublicLTNavParamKey]func(PublicLTNavResponse) error{},
 	}, nil
 }
 
 // Private :
 func (s *WebsocketClientV5) Private() (PrivateServiceI, error) {
-	url := s.Client.BaseURL + PrivatePath
-	c, _, err := s.Client.Dialer.Dial(url, nil)
+	c, _, err := s.Client.Dialer.Dial(s.Client.BaseURL+PrivatePath, nil)
 	if err != nil {
 		return nil, err
 	}
 	return &PrivateService{
 		client:           s.Client,
 		connection:       c,
+		path:             PrivatePath,
 		paramOrderMap:    map[PrivateParamKey]func(PrivateOrderResponse) error{},
 		paramPositionMap: map[PrivateParamKey]func(PrivatePositionResponse) error{},
 		paramWalletMap:   map[PrivateParamKey]func(PrivateWalletResponse) error{},